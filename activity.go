@@ -0,0 +1,169 @@
+package linkedinscraper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// GetProfileActivity fetches up to count recent engagements of the member identified by
+// profileURN (e.g. "urn:li:fsd_profile:ACoAAA..."): the updates they reacted to, commented
+// on or reposted. The member's own posts are not included; use GetProfilePosts for those.
+// The query IDs in Config.ProfileActivityQueryIDs are tried in order.
+func (c *Client) GetProfileActivity(ctx context.Context, profileURN string, count int) ([]Activity, error) {
+	ctx = withOperation(ctx, "GetProfileActivity")
+
+	// Input Validation
+	if c.config.Auth.LiAtCookie == "" || c.config.Auth.CSRFToken == "" {
+		return nil, ErrAuthMissing
+	}
+	if profileURN == "" {
+		return nil, fmt.Errorf("profileURN cannot be empty")
+	}
+	if count <= 0 {
+		count = 20
+	}
+
+	// Prepare Headers
+	customHeaders := http.Header{}
+	customHeaders.Set("Accept", AcceptHeaderValue)
+	customHeaders.Set("X-Li-Pem-Metadata", "Voyager - Profile=recent-activity")
+
+	// Try each configured query ID in order, falling back when LinkedIn reports one as deprecated
+	apiResponse, err := withQueryIDFallback(c.config.profileActivityQueryIDs(), func(queryID string) (*ProfileActivityAPIResponse, error) {
+		// Build URL
+		requestURL, err := buildProfileUpdatesGraphQLURL(VoyagerBaseURL, queryID, profileURN, count)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrRequestBuildFailed, err)
+		}
+
+		// Make API Call
+		resp, respBodyBytes, err := c.makeRequest(ctx, http.MethodGet, requestURL, customHeaders, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrRequestFailed, err)
+		}
+
+		// Error Handling (HTTP Status)
+		if err := statusError(resp, respBodyBytes); err != nil {
+			return nil, err
+		}
+
+		// Parse JSON Response
+		var apiResponse ProfileActivityAPIResponse
+		if err := c.decodeResponse(respBodyBytes, &apiResponse); err != nil {
+			return nil, fmt.Errorf("%w: %v. Raw response: %s", ErrResponseParseFailed, err, string(respBodyBytes))
+		}
+		return &apiResponse, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return parseActivityFromAPIResponse(apiResponse), nil
+}
+
+// activityHeaderMarkers maps lowercase header fragments to the engagement they announce.
+// Comments and reposts are checked before reactions, and every reaction LinkedIn offers
+// counts as a like.
+var activityHeaderMarkers = []struct {
+	marker       string
+	activityType ActivityType
+}{
+	{"commented", ActivityTypeComment},
+	{"replied", ActivityTypeComment},
+	{"reposted", ActivityTypeShare},
+	{"shared", ActivityTypeShare},
+	{"likes", ActivityTypeLike},
+	{"loves", ActivityTypeLike},
+	{"celebrates", ActivityTypeLike},
+	{"supports", ActivityTypeLike},
+	{"finds this", ActivityTypeLike}, // "finds this insightful/funny"
+	{"is curious", ActivityTypeLike},
+	{"reacted", ActivityTypeLike},
+}
+
+// activityTypeFromHeader classifies an update header, returning "" for updates that
+// were not surfaced by an engagement.
+func activityTypeFromHeader(header string) ActivityType {
+	lowered := strings.ToLower(header)
+	for _, m := range activityHeaderMarkers {
+		if strings.Contains(lowered, m.marker) {
+			return m.activityType
+		}
+	}
+	return ""
+}
+
+// parseActivityFromAPIResponse builds Activities from the Update entities carrying an
+// engagement header, in response order. Comments are joined to their update by the
+// activity URN embedded in the comment URN, and reposts resolve their target through the
+// reshared update.
+func parseActivityFromAPIResponse(apiResponse *ProfileActivityAPIResponse) []Activity {
+	updates := make(map[string]FeedIncludedElement)
+	comments := make(map[string]FeedIncludedElement)
+	for _, item := range apiResponse.Included {
+		switch item.Type {
+		case EntityTypeUpdate:
+			updates[item.EntityURN] = item
+		case EntityTypeComment:
+			if target := commentTargetURN(item.URN); target != "" {
+				if _, seen := comments[target]; !seen {
+					comments[target] = item
+				}
+			}
+		}
+	}
+
+	activities := []Activity{}
+	for _, item := range apiResponse.Included {
+		if item.Type != EntityTypeUpdate || item.Header == nil || item.Metadata == nil || item.Metadata.BackendURN == "" {
+			continue
+		}
+		activity := Activity{
+			Type:      activityTypeFromHeader(string(item.Header.Text)),
+			TargetURN: item.Metadata.BackendURN,
+		}
+
+		switch activity.Type {
+		case ActivityTypeComment:
+			if comment, ok := comments[activity.TargetURN]; ok {
+				if comment.Commentary != nil {
+					activity.Text = string(comment.Commentary.Text)
+				}
+				if comment.CreatedAt > 0 {
+					activity.CreatedAt = time.UnixMilli(int64(comment.CreatedAt)).UTC()
+				}
+			}
+		case ActivityTypeShare:
+			// The update is the repost itself; its target is the update it reshared.
+			activity.CreatedAt = activityTime(item.Metadata.BackendURN)
+			if item.Commentary != nil {
+				activity.Text = string(item.Commentary.Text)
+			}
+			if original, ok := updates[item.ResharedUpdate]; ok && original.Metadata != nil && original.Metadata.BackendURN != "" {
+				activity.TargetURN = original.Metadata.BackendURN
+			}
+		case ActivityTypeLike:
+		default:
+			continue
+		}
+		activities = append(activities, activity)
+	}
+	return activities
+}
+
+// commentTargetURN returns the activity URN a comment belongs to, e.g.
+// "urn:li:activity:123" for "urn:li:comment:(urn:li:activity:123,456)", or "".
+func commentTargetURN(commentURN string) string {
+	inner, ok := strings.CutPrefix(commentURN, "urn:li:comment:(")
+	if !ok {
+		return ""
+	}
+	target, _, ok := strings.Cut(inner, ",")
+	if !ok {
+		return ""
+	}
+	return target
+}
@@ -0,0 +1,73 @@
+package linkedinscraper_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("GetProfileActivity", func() {
+	const profileURN = "urn:li:fsd_profile:ACoAAAJaneDoe"
+
+	It("parses reposts, comments and reactions", func() {
+		transport := newFakeTransport(http.StatusOK, loadFixture("profile_activity.json"))
+		activities, err := newTestClient(transport).GetProfileActivity(context.Background(), profileURN, 3)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(transport.Requests()[0].URL.RawQuery).To(ContainSubstring("queryId=" + linkedinscraper.DefaultProfileActivityQueryID))
+		Expect(transport.Requests()[0].URL.RawQuery).To(ContainSubstring("profileUrn:urn%3Ali%3Afsd_profile%3AACoAAAJaneDoe"))
+
+		Expect(activities).To(Equal([]linkedinscraper.Activity{
+			{
+				Type:      linkedinscraper.ActivityTypeShare,
+				TargetURN: "urn:li:activity:7191405998899212345",
+				CreatedAt: time.Date(2024, time.May, 3, 9, 0, 0, 0, time.UTC),
+				Text:      "Worth a read for every founder.",
+			},
+			{
+				Type:      linkedinscraper.ActivityTypeComment,
+				TargetURN: "urn:li:activity:7190243337830412345",
+				CreatedAt: time.UnixMilli(1714300000000).UTC(),
+				Text:      "Congrats on the launch!",
+			},
+			{
+				Type:      linkedinscraper.ActivityTypeLike,
+				TargetURN: "urn:li:activity:7187359334400012345",
+			},
+		}))
+	})
+
+	It("falls back to the next configured query ID", func() {
+		transport := &fakeTransport{handler: func(req *http.Request) *http.Response {
+			if strings.Contains(req.URL.RawQuery, "queryId=old.1") {
+				return newResponse(http.StatusBadRequest, []byte(`{"errors":[{"message":"PersistedQueryNotFound"}]}`))
+			}
+			return newResponse(http.StatusOK, loadFixture("profile_activity.json"))
+		}}
+		client := newTestClient(transport, func(cfg *linkedinscraper.Config) {
+			cfg.ProfileActivityQueryIDs = []string{"old.1", "new.2"}
+		})
+
+		activities, err := client.GetProfileActivity(context.Background(), profileURN, 3)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(activities).To(HaveLen(3))
+
+		requests := transport.Requests()
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[0].URL.RawQuery).To(ContainSubstring("queryId=old.1"))
+		Expect(requests[1].URL.RawQuery).To(ContainSubstring("queryId=new.2"))
+	})
+
+	It("returns an empty slice for a member without activity", func() {
+		body := []byte(`{"data":{"data":{}},"included":[]}`)
+		activities, err := newTestClient(newFakeTransport(http.StatusOK, body)).GetProfileActivity(context.Background(), profileURN, 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(activities).To(BeEmpty())
+	})
+})
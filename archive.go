@@ -0,0 +1,131 @@
+package linkedinscraper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ResponseArchiver receives every raw API response body for archiving, labelled with the
+// client operation that made the request (e.g. "GetProfile") and the sanitized request URL.
+type ResponseArchiver func(operation string, reqURL string, body []byte) error
+
+// WithResponseArchiver calls archive with each raw response body, including those of
+// retried attempts. Archiving errors are logged and never fail the request.
+func WithResponseArchiver(archive ResponseArchiver) ClientOption {
+	return func(c *Client) {
+		c.archiver = archive
+	}
+}
+
+// WithRecordFixtures writes every raw response body to dir as {operation}-{timestamp}.json,
+// e.g. "GetProfile-20250102T150405.000000000Z.json", creating dir if needed. Run integration
+// tests with it to capture real responses as testdata for offline specs; the bodies are
+// stored verbatim, so review them for personal data before committing. Like archiving,
+// recording includes retried attempts and its errors are logged, never returned.
+func WithRecordFixtures(dir string) ClientOption {
+	return func(c *Client) {
+		c.fixtureDir = dir
+	}
+}
+
+// fixtureTimestampLayout names recorded fixtures so they sort chronologically.
+const fixtureTimestampLayout = "20060102T150405.000000000Z"
+
+// recordFixture writes body to a new file in the fixture directory. A numeric suffix
+// keeps responses received within the same clock tick from overwriting each other.
+func (c *Client) recordFixture(operation string, body []byte) error {
+	if err := os.MkdirAll(c.fixtureDir, 0o755); err != nil {
+		return err
+	}
+	base := operation + "-" + c.now().UTC().Format(fixtureTimestampLayout)
+	for attempt := 0; ; attempt++ {
+		name := base + ".json"
+		if attempt > 0 {
+			name = fmt.Sprintf("%s-%d.json", base, attempt)
+		}
+		file, err := os.OpenFile(filepath.Join(c.fixtureDir, name), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if errors.Is(err, fs.ErrExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := file.Write(body); err != nil {
+			file.Close()
+			return err
+		}
+		return file.Close()
+	}
+}
+
+// operationKey is the context key carrying the operation label for archiving.
+type operationKey struct{}
+
+// withOperation labels the requests made with ctx as belonging to operation.
+func withOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// operationFromContext returns the operation label set by withOperation, or "request".
+func operationFromContext(ctx context.Context) string {
+	if operation, ok := ctx.Value(operationKey{}).(string); ok {
+		return operation
+	}
+	return "request"
+}
+
+// sensitiveQueryParams are query parameter name fragments whose values are redacted
+// from archived URLs.
+var sensitiveQueryParams = []string{"token", "csrf", "secret", "password", "key"}
+
+// sanitizeURL strips user info and redacts credential-like query parameters so URLs can
+// be stored alongside archived responses. The literal Rest.li variables are preserved.
+func sanitizeURL(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	parsed.User = nil
+
+	parts := strings.Split(parsed.RawQuery, "&")
+	for i, part := range parts {
+		name, _, found := strings.Cut(part, "=")
+		if !found {
+			continue
+		}
+		lowered := strings.ToLower(name)
+		for _, sensitive := range sensitiveQueryParams {
+			if strings.Contains(lowered, sensitive) {
+				parts[i] = name + "=REDACTED"
+				break
+			}
+		}
+	}
+	parsed.RawQuery = strings.Join(parts, "&")
+	return parsed.String()
+}
+
+// archiveResponse hands body to the configured archiver and fixture recorder, logging
+// rather than returning errors.
+func (c *Client) archiveResponse(ctx context.Context, urlStr string, body []byte) {
+	if body == nil || (c.archiver == nil && c.fixtureDir == "") {
+		return
+	}
+	operation := operationFromContext(ctx)
+	if c.archiver != nil {
+		if err := c.archiver(operation, sanitizeURL(urlStr), body); err != nil {
+			c.logWarn("response archiver failed", "operation", operation, "error", err)
+		}
+	}
+	if c.fixtureDir != "" {
+		if err := c.recordFixture(operation, body); err != nil {
+			c.logWarn("fixture recorder failed", "operation", operation, "error", err)
+		}
+	}
+}
@@ -1,26 +1,121 @@
 package linkedinscraper
 
 import (
+	"bytes"
 	"compress/gzip"
+	"compress/zlib"
 	"context"
-	"encoding/json"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
 )
 
 // Client is the LinkedIn API client.
 type Client struct {
-	httpClient *http.Client
-	config     *Config
+	httpClient         *http.Client
+	config             *Config
+	requestIDGenerator func() string
+	logger             *slog.Logger
+	throttle           *requestThrottle // Per-egress request spacing, nil when unthrottled
+	archiver           ResponseArchiver
+	fixtureDir         string                // Directory raw responses are recorded to, see WithRecordFixtures
+	now                func() time.Time      // Clock for timestamps, time.Now by default
+	provenance         bool                  // Record Provenance on results, see WithProvenance
+	metricsHook        func(ResponseMetrics) // Called per response body, see WithResponseMetrics
+	bytesRead          atomic.Int64          // Decompressed response bytes, see TotalBytesRead
+
+	mu               sync.Mutex // Guards the fields below
+	rateLimit        RateLimitStatus
+	credentials      []AuthCredentials // Rotation pool, Config.Auth first; nil without rotation
+	activeCredential int               // Index into credentials
+	companyNames     map[string]string // Universal names by company ID, see ResolveCompanyUniversalName
+	unknownFields    map[string]bool   // Response field paths already logged, see Config.StrictDecoding
+}
+
+// ClientOption customizes a Client at construction time.
+type ClientOption func(*Client)
+
+// WithHTTPClient replaces the default HTTP client, e.g. to supply a custom transport.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithRequestIDGenerator sets an X-Request-ID header on every outgoing request using
+// the value returned by generate. The header is meant for your own logging and proxies
+// to correlate calls; when no generator is set, no header is added.
+func WithRequestIDGenerator(generate func() string) ClientOption {
+	return func(c *Client) {
+		c.requestIDGenerator = generate
+	}
+}
+
+// WithLogger sets the structured logger used for diagnostics. Without it the client is silent.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithClock replaces time.Now as the source of the client's timestamps, such as
+// LinkedInProfile.FetchedAt, so tests and replays can pin them.
+func WithClock(now func() time.Time) ClientOption {
+	return func(c *Client) {
+		if now != nil {
+			c.now = now
+		}
+	}
+}
+
+// WithProvenance makes GetProfile and the SearchProfiles family record on each returned
+// profile the query ID and variables of the request it came from, see Provenance.
+func WithProvenance() ClientOption {
+	return func(c *Client) {
+		c.provenance = true
+	}
+}
+
+// parseOptions returns the config's parsing settings, stamped with the current time.
+func (c *Client) parseOptions() parseOptions {
+	opts := c.config.parseOptions()
+	opts.fetchedAt = c.now()
+	return opts
+}
+
+// logDebug logs at debug level when a logger is configured.
+func (c *Client) logDebug(msg string, args ...any) {
+	if c.logger != nil {
+		c.logger.Debug(msg, args...)
+	}
+}
+
+// logWarn logs at warn level when a logger is configured.
+func (c *Client) logWarn(msg string, args ...any) {
+	if c.logger != nil {
+		c.logger.Warn(msg, args...)
+	}
 }
 
 // NewClient creates a new LinkedIn API client.
-func NewClient(cfg *Config) (*Client, error) {
+func NewClient(cfg *Config, opts ...ClientOption) (*Client, error) {
 	if cfg == nil {
 		return nil, errors.New("linkedinscraper: config cannot be nil") // Consider defining a specific error for this
 	}
@@ -28,20 +123,42 @@ func NewClient(cfg *Config) (*Client, error) {
 	httpClient := &http.Client{
 		Timeout: 30 * time.Second, // Go's default http.Transport handles gzip automatically
 	}
+	if cfg.ProxyURL != "" || cfg.InsecureSkipVerify {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		if cfg.ProxyURL != "" {
+			proxyURL, err := url.Parse(cfg.ProxyURL)
+			if err != nil || proxyURL.Host == "" {
+				return nil, fmt.Errorf("linkedinscraper: invalid proxy URL %q", cfg.ProxyURL)
+			}
+			transport.Proxy = http.ProxyURL(proxyURL)
+		}
+		if cfg.InsecureSkipVerify {
+			if transport.TLSClientConfig == nil {
+				transport.TLSClientConfig = &tls.Config{}
+			}
+			transport.TLSClientConfig.InsecureSkipVerify = true
+		}
+		httpClient.Transport = transport
+	}
 
-	return &Client{httpClient: httpClient, config: cfg}, nil
-}
-
-// buildGraphQLURL constructs the full URL for a GraphQL API request.
-// It takes the base URL, query ID, and variables, then assembles them.
-func buildGraphQLURL(baseURL, queryID string, variables SearchVariables) (string, error) {
-	parsedBaseURL, err := url.Parse(baseURL)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse base URL: %w", err)
+	c := &Client{httpClient: httpClient, config: cfg, now: time.Now}
+	for _, opt := range opts {
+		opt(c)
 	}
 
+	return c, nil
+}
+
+// BuildSearchVariablesString returns the Rest.li "variables" value a people search sends,
+// exactly as it appears after "variables=" in the request URL, e.g.
+//
+//	(start:0,count:10,origin:FACETED_SEARCH,query:(keywords:investor,flagshipSearchIntent:SEARCH_SRP,queryParameters:List((key:network,value:List(F,O)),(key:resultType,value:List(PEOPLE))),includeFiltersInResponse:false))
+//
+// Keywords, the origin and any pagination token are percent-encoded; facet values are emitted
+// verbatim. It is useful to compare the client's requests against a captured cURL when a
+// query ID stops working.
+func BuildSearchVariablesString(variables SearchVariables) string {
 	// Manually construct the variables string to match the cURL format
-	// (start:0,count:1,origin:FACETED_SEARCH,query:(keywords:investor,flagshipSearchIntent:SEARCH_SRP,queryParameters:List((key:network,value:List(F,O)),(key:resultType,value:List(PEOPLE))),includeFiltersInResponse:false))
 	var queryParams []string
 	for _, p := range variables.Query.QueryParameters {
 		// Assuming p.Value is always a list of strings for now.
@@ -54,17 +171,32 @@ func buildGraphQLURL(baseURL, queryID string, variables SearchVariables) (string
 	// Ensure keywords are properly escaped for the URL query string part, but not for the graphql variable part
 	// The variable string itself is a single query parameter value, so special characters within it are fine.
 	// However, if keywords themselves contain characters like '(', ')', ',', they should be as-is per cURL.
+	paginationToken := ""
+	if variables.PaginationToken != "" {
+		paginationToken = ",paginationToken:" + escapeRestliString(variables.PaginationToken)
+	}
 
-	// Reverted: Use full variablesString including queryParameters
-	variablesString := fmt.Sprintf("(start:%d,count:%d,origin:%s,query:(keywords:%s,flagshipSearchIntent:%s,queryParameters:%s,includeFiltersInResponse:%t))",
+	return fmt.Sprintf("(start:%d,count:%d%s,origin:%s,query:(keywords:%s,flagshipSearchIntent:%s,queryParameters:%s,includeFiltersInResponse:%t))",
 		variables.Start,
 		variables.Count,
-		variables.Origin,
-		url.QueryEscape(variables.Query.Keywords), // URL Encode the keywords string for spaces etc.
+		paginationToken,
+		escapeRestliString(variables.Origin),
+		escapeRestliString(variables.Query.Keywords), // Percent-encode so boolean syntax survives Rest.li decoding
 		variables.Query.FlagshipSearchIntent,
-		queryParametersString, // Reverted: Include queryParametersString
+		queryParametersString,
 		variables.Query.IncludeFiltersInResponse,
 	)
+}
+
+// buildGraphQLURL constructs the full URL for a GraphQL API request.
+// It takes the base URL, query ID, and variables, then assembles them.
+func buildGraphQLURL(baseURL, queryID string, variables SearchVariables) (string, error) {
+	parsedBaseURL, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse base URL: %w", err)
+	}
+
+	variablesString := BuildSearchVariablesString(variables)
 
 	query := parsedBaseURL.Query()
 	query.Set("queryId", queryID)
@@ -96,6 +228,29 @@ func buildGraphQLURL(baseURL, queryID string, variables SearchVariables) (string
 	return parsedBaseURL.String(), nil
 }
 
+// escapeRestliString percent-encodes every byte outside the RFC 3986 unreserved set.
+// Unlike url.QueryEscape it encodes spaces as %20 rather than '+', which Rest.li would
+// keep as a literal plus, and it escapes the Rest.li delimiters ( ) , : so quoted
+// phrases and boolean operators such as `"software engineer" AND (Go OR Rust) NOT intern`
+// reach LinkedIn intact.
+func escapeRestliString(s string) string {
+	const hex = "0123456789ABCDEF"
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if ('a' <= ch && ch <= 'z') || ('A' <= ch && ch <= 'Z') || ('0' <= ch && ch <= '9') ||
+			ch == '-' || ch == '_' || ch == '.' || ch == '~' {
+			b.WriteByte(ch)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte(hex[ch>>4])
+		b.WriteByte(hex[ch&0x0F])
+	}
+	return b.String()
+}
+
 // stringSliceToString joins a slice of strings with a separator.
 // Helper function for constructing parts of the variables string.
 func stringSliceToString(slice []string, sep string) string {
@@ -105,18 +260,32 @@ func stringSliceToString(slice []string, sep string) string {
 // buildProfileGraphQLURL constructs the full URL for a profile GraphQL API request.
 // It takes the base URL, query ID, and publicIdentifier, then assembles them.
 func buildProfileGraphQLURL(baseURL, queryID, publicIdentifier string) (string, error) {
+	// For profile fetching, the variables format is:
+	// variables=(vanityName:publicIdentifier)
+	return buildProfileVariablesURL(baseURL, queryID, profileVariables(publicIdentifier))
+}
+
+// profileVariables returns the Rest.li variables of a profile lookup by public identifier.
+func profileVariables(publicIdentifier string) string {
+	return fmt.Sprintf("(vanityName:%s)", publicIdentifier)
+}
+
+// buildProfileVariablesURL constructs a profile GraphQL API URL from a pre-built variables string.
+func buildProfileVariablesURL(baseURL, queryID, variablesString string) (string, error) {
+	return buildProfileVariablesURLWithMetadata(baseURL, queryID, variablesString, true)
+}
+
+// buildProfileVariablesURLWithMetadata is like buildProfileVariablesURL but lets the caller
+// drop the web metadata LinkedIn otherwise includes in the response.
+func buildProfileVariablesURLWithMetadata(baseURL, queryID, variablesString string, includeWebMetadata bool) (string, error) {
 	parsedBaseURL, err := url.Parse(baseURL)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse base URL: %w", err)
 	}
 
-	// For profile fetching, the variables format is:
-	// variables=(vanityName:publicIdentifier)
-	variablesString := fmt.Sprintf("(vanityName:%s)", publicIdentifier)
-
 	query := parsedBaseURL.Query()
 	query.Set("queryId", queryID)
-	query.Set("includeWebMetadata", "true")
+	query.Set("includeWebMetadata", strconv.FormatBool(includeWebMetadata))
 
 	// Encode the base query parameters
 	encodedBaseQuery := query.Encode()
@@ -130,6 +299,38 @@ func buildProfileGraphQLURL(baseURL, queryID, publicIdentifier string) (string,
 
 // GetProfile fetches a detailed LinkedIn profile by public identifier.
 func (c *Client) GetProfile(ctx context.Context, publicIdentifier string) (*LinkedInProfile, error) {
+	result, err := c.GetProfileVerbose(ctx, publicIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	return result.Profile, nil
+}
+
+// GetProfileVerbose is like GetProfile but also returns per-section parse coverage, which
+// quickly reveals when LinkedIn changes an entity $type string.
+func (c *Client) GetProfileVerbose(ctx context.Context, publicIdentifier string) (*ProfileResult, error) {
+	return c.getProfile(withOperation(ctx, "GetProfile"), publicIdentifier, "")
+}
+
+// GetProfileLocalized is like GetProfile but requests the language variant of the profile
+// for locale, e.g. "ja_JP", as LinkedIn shows it to members browsing in that language.
+// For members who maintain their profile in several languages the names, headline and
+// summary come back in that language, falling back to another variant of the same
+// language and then to the default version; LinkedInProfile.Locale records the locale.
+func (c *Client) GetProfileLocalized(ctx context.Context, publicIdentifier, locale string) (*LinkedInProfile, error) {
+	if locale == "" {
+		return nil, fmt.Errorf("locale cannot be empty")
+	}
+	result, err := c.getProfile(withOperation(ctx, "GetProfileLocalized"), publicIdentifier, liLangForLocale(locale))
+	if err != nil {
+		return nil, err
+	}
+	return result.Profile, nil
+}
+
+// getProfile fetches and parses a full profile, in the language variant for locale when
+// it is not empty.
+func (c *Client) getProfile(ctx context.Context, publicIdentifier, locale string) (*ProfileResult, error) {
 	// Input Validation
 	if c.config.Auth.LiAtCookie == "" || c.config.Auth.CSRFToken == "" {
 		return nil, ErrAuthMissing
@@ -138,13 +339,99 @@ func (c *Client) GetProfile(ctx context.Context, publicIdentifier string) (*Link
 		return nil, fmt.Errorf("publicIdentifier cannot be empty")
 	}
 
-	// Build URL
-	requestURL, err := buildProfileGraphQLURL(VoyagerBaseURL, DefaultProfileQueryID, publicIdentifier)
+	customHeaders := c.profileRequestHeaders(publicIdentifier)
+	if locale != "" {
+		customHeaders.Set("X-Li-Lang", locale)
+		customHeaders.Set("Accept-Language", acceptLanguageForLocale(locale))
+	}
+
+	var usedQueryID string
+	apiResponse, err := withQueryIDFallback(c.config.profileQueryIDs(), func(queryID string) (*ProfileAPIResponse, error) {
+		usedQueryID = queryID
+		// Build URL
+		requestURL, err := buildProfileGraphQLURL(VoyagerBaseURL, queryID, publicIdentifier)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrRequestBuildFailed, err)
+		}
+		return c.fetchProfileResponse(ctx, requestURL, customHeaders)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrRequestBuildFailed, err)
+		return nil, err
 	}
 
-	// Prepare Headers
+	if c.config.FetchAllSections {
+		if err := c.fetchRemainingSections(ctx, apiResponse, publicIdentifier); err != nil {
+			return nil, err
+		}
+	}
+
+	// Extract Profile from Response using comprehensive parsing
+	opts := c.parseOptions()
+	opts.locale = locale
+	profile, err := convertAPIResponseToLinkedInProfile(apiResponse, publicIdentifier, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract profile from response: %w", err)
+	}
+	if c.provenance {
+		profile.Provenance = &Provenance{
+			Operation: operationFromContext(ctx),
+			QueryID:   usedQueryID,
+			Variables: profileVariables(publicIdentifier),
+		}
+	}
+
+	stats := computeParseStats(apiResponse, profile)
+	c.logDebug("profile parse coverage",
+		"publicIdentifier", publicIdentifier,
+		"experienceSeen", stats.Experience.Seen, "experienceParsed", stats.Experience.Parsed,
+		"educationSeen", stats.Education.Seen, "educationParsed", stats.Education.Parsed,
+		"skillsSeen", stats.Skills.Seen, "skillsParsed", stats.Skills.Parsed,
+		"certificationsSeen", stats.Certifications.Seen, "certificationsParsed", stats.Certifications.Parsed,
+	)
+
+	return &ProfileResult{Profile: profile, Stats: stats}, nil
+}
+
+// GetProfileLite fetches only the top-card fields of a profile: name, headline, location,
+// picture and current company. It requests the top-card decoration
+// (DefaultProfileTopCardQueryID) without web metadata and skips the section parsers,
+// which suits high-volume enrichment. Should LinkedIn reject that query ID, it falls back
+// to the full profile query IDs. The result has reduced fidelity: Experience, Education,
+// Skills and the other sections are always empty, and CurrentCompany is only as good as
+// the positions LinkedIn includes in the response.
+func (c *Client) GetProfileLite(ctx context.Context, publicIdentifier string) (*LinkedInProfile, error) {
+	ctx = withOperation(ctx, "GetProfileLite")
+
+	if c.config.Auth.LiAtCookie == "" || c.config.Auth.CSRFToken == "" {
+		return nil, ErrAuthMissing
+	}
+	if publicIdentifier == "" {
+		return nil, fmt.Errorf("publicIdentifier cannot be empty")
+	}
+
+	customHeaders := c.profileRequestHeaders(publicIdentifier)
+
+	queryIDs := append([]string{DefaultProfileTopCardQueryID}, c.config.profileQueryIDs()...)
+	apiResponse, err := withQueryIDFallback(queryIDs, func(queryID string) (*ProfileAPIResponse, error) {
+		requestURL, err := buildProfileVariablesURLWithMetadata(VoyagerBaseURL, queryID, profileVariables(publicIdentifier), false)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrRequestBuildFailed, err)
+		}
+		return c.fetchProfileResponse(ctx, requestURL, customHeaders)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := convertAPIResponseToLiteProfile(apiResponse, publicIdentifier, c.parseOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract profile from response: %w", err)
+	}
+	return profile, nil
+}
+
+// profileRequestHeaders returns the headers the web app sends when viewing a profile.
+func (c *Client) profileRequestHeaders(publicIdentifier string) http.Header {
 	customHeaders := http.Header{}
 	customHeaders.Set("Accept", AcceptHeaderValue)
 
@@ -159,47 +446,528 @@ func (c *Client) GetProfile(ctx context.Context, publicIdentifier string) (*Link
 	customHeaders.Set("X-Li-Pem-Metadata", "Voyager - Profile")
 
 	// Set X-Li-Track with appropriate context for profile viewing
-	xLiTrack := `{"clientVersion":"1.13.35368","mpVersion":"1.13.35368","osName":"web","timezoneOffset":-7,"timezone":"America/Los_Angeles","deviceFormFactor":"DESKTOP","mpName":"voyager-web","displayDensity":2,"displayWidth":1920,"displayHeight":1080}`
-	customHeaders.Set("X-Li-Track", xLiTrack)
+	customHeaders.Set("X-Li-Track", c.config.xLiTrack(c.now()))
+	return customHeaders
+}
+
+// GetProfileByMemberID fetches a detailed LinkedIn profile by numeric member ID,
+// for sources that provide neither a public identifier nor a profile URN.
+func (c *Client) GetProfileByMemberID(ctx context.Context, memberID int64) (*LinkedInProfile, error) {
+	ctx = withOperation(ctx, "GetProfileByMemberID")
+
+	// Input Validation
+	if c.config.Auth.LiAtCookie == "" || c.config.Auth.CSRFToken == "" {
+		return nil, ErrAuthMissing
+	}
+	if memberID <= 0 {
+		return nil, fmt.Errorf("memberID must be positive, got %d", memberID)
+	}
+
+	memberURN := fmt.Sprintf("urn:li:member:%d", memberID)
+	variablesString := fmt.Sprintf("(memberIdentity:%s)", escapeRestliString(memberURN))
+
+	// Prepare Headers
+	customHeaders := http.Header{}
+	customHeaders.Set("Accept", AcceptHeaderValue)
+	customHeaders.Set("X-Li-Pem-Metadata", "Voyager - Profile")
+	customHeaders.Set("X-Li-Track", c.config.xLiTrack(c.now()))
+
+	apiResponse, err := withQueryIDFallback(c.config.profileQueryIDs(), func(queryID string) (*ProfileAPIResponse, error) {
+		// Build URL
+		requestURL, err := buildProfileVariablesURL(VoyagerBaseURL, queryID, variablesString)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrRequestBuildFailed, err)
+		}
+		return c.fetchProfileResponse(ctx, requestURL, customHeaders)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := convertMemberResponseToLinkedInProfile(apiResponse, memberURN, c.parseOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract profile from response: %w", err)
+	}
+
+	return profile, nil
+}
+
+// GetProfilesOptions configures GetProfiles.
+type GetProfilesOptions struct {
+	// Concurrency is the number of profiles fetched in parallel. Defaults to
+	// DefaultGetProfilesConcurrency when zero.
+	Concurrency int
+	// StopOnError cancels outstanding fetches on the first error and returns it along
+	// with the profiles fetched so far, instead of collecting every error.
+	StopOnError bool
+	// Skip404 treats identifiers LinkedIn answers with 404, typically deleted accounts,
+	// as empty results: no error is reported for them, and the returned slice keeps one
+	// entry per identifier in input order, nil for 404s and failures alike. Other
+	// failures are still reported in the error.
+	Skip404 bool
+	// MinConcurrency is the floor the effective concurrency never drops below when
+	// backing off from rate limits. Defaults to 1 when zero; capped at Concurrency.
+	MinConcurrency int
+	// RateLimitRetries is how many times an identifier answered with 429 is fetched
+	// again, after the same exponential backoff as request retries: Config.RetryBackoff
+	// doubled per attempt, capped at MaxRetryBackoff. Zero reports the 429 right away.
+	RateLimitRetries int
+	// OnConcurrencyChange, if set, is called with the new effective concurrency each time
+	// it adapts. Calls are serialized but come from the fetching goroutines.
+	OnConcurrencyChange func(concurrency int)
+}
+
+// GetProfiles fetches the profiles for publicIdentifiers with a bounded pool of parallel
+// GetProfile calls. Profiles are returned in input order, omitting those that failed
+// unless Skip404 is set. The error joins one error per failed identifier, or is the first
+// error with StopOnError.
+//
+// The number of fetches in flight adapts to rate limiting: every 429 halves it, down to
+// MinConcurrency, and it grows back by one after each run of as many successes, up to
+// Concurrency.
+func (c *Client) GetProfiles(ctx context.Context, publicIdentifiers []string, opts GetProfilesOptions) ([]*LinkedInProfile, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultGetProfilesConcurrency
+	}
+	workers := min(concurrency, len(publicIdentifiers))
+	limiter := newAdaptiveLimiter(opts.MinConcurrency, workers, opts.OnConcurrencyChange)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]*LinkedInProfile, len(publicIdentifiers))
+	errs := make([]error, len(publicIdentifiers))
+	var firstErr error
+	var firstErrOnce sync.Once
+
+	// fetch gets one profile within the limiter, retrying 429s as allowed. It returns
+	// nil, nil when ctx ends while waiting, leaving the identifier as not fetched.
+	fetch := func(publicIdentifier string) (*LinkedInProfile, error) {
+		for attempt := 0; ; attempt++ {
+			epoch, err := limiter.acquire(ctx)
+			if err != nil {
+				return nil, nil
+			}
+			profile, err := c.GetProfile(ctx, publicIdentifier)
+			rateLimited := errors.Is(err, ErrRateLimited)
+			limiter.release(epoch, rateLimited, err == nil)
+			if !rateLimited || attempt >= opts.RateLimitRetries {
+				return profile, err
+			}
+
+			select {
+			case <-time.After(c.retryDelay(attempt)):
+			case <-ctx.Done():
+				return nil, err
+			}
+		}
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if ctx.Err() != nil {
+					continue // Stopped; drain the remaining jobs
+				}
+				profile, err := fetch(publicIdentifiers[i])
+				if profile == nil && err == nil {
+					continue
+				}
+				if opts.Skip404 && errors.Is(err, ErrNotFound) {
+					continue
+				}
+				if err != nil {
+					errs[i] = fmt.Errorf("%s: %w", publicIdentifiers[i], err)
+					if opts.StopOnError {
+						firstErrOnce.Do(func() {
+							firstErr = errs[i]
+							cancel()
+						})
+					}
+					continue
+				}
+				results[i] = profile
+			}
+		}()
+	}
+dispatch:
+	for i := range publicIdentifiers {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	profiles := results
+	if !opts.Skip404 {
+		profiles = slices.DeleteFunc(results, func(profile *LinkedInProfile) bool { return profile == nil })
+	}
+	if firstErr != nil {
+		return profiles, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err) // The caller's context ended before every identifier was fetched
+	}
+	return profiles, errors.Join(errs...)
+}
+
+// BatchGetProfilesByURN fetches several profiles with one request per MaxProfileBatchSize
+// URNs instead of one request each. URNs may be profile ("urn:li:fsd_profile:...") or
+// member ("urn:li:member:...") URNs. Profiles are returned in input order; URNs that the
+// response does not include are omitted.
+func (c *Client) BatchGetProfilesByURN(ctx context.Context, urns []string) ([]*LinkedInProfile, error) {
+	ctx = withOperation(ctx, "BatchGetProfilesByURN")
+
+	// Input Validation
+	if c.config.Auth.LiAtCookie == "" || c.config.Auth.CSRFToken == "" {
+		return nil, ErrAuthMissing
+	}
+
+	// Prepare Headers
+	customHeaders := http.Header{}
+	customHeaders.Set("Accept", AcceptHeaderValue)
+	customHeaders.Set("X-Li-Pem-Metadata", "Voyager - Profile")
+	customHeaders.Set("X-Li-Track", c.config.xLiTrack(c.now()))
+
+	var profiles []*LinkedInProfile
+	for start := 0; start < len(urns); start += MaxProfileBatchSize {
+		batch := urns[start:min(start+MaxProfileBatchSize, len(urns))]
+
+		escaped := make([]string, len(batch))
+		for i, urn := range batch {
+			escaped[i] = escapeRestliString(urn)
+		}
+		variablesString := fmt.Sprintf("(memberIdentities:List(%s))", strings.Join(escaped, ","))
+
+		apiResponse, err := withQueryIDFallback(c.config.profileQueryIDs(), func(queryID string) (*ProfileAPIResponse, error) {
+			// Build URL
+			requestURL, err := buildProfileVariablesURL(VoyagerBaseURL, queryID, variablesString)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrRequestBuildFailed, err)
+			}
+			return c.fetchProfileResponse(ctx, requestURL, customHeaders)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		batchProfiles, err := convertBatchResponseToLinkedInProfiles(apiResponse, batch, c.parseOptions())
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract profiles from response: %w", err)
+		}
+		profiles = append(profiles, batchProfiles...)
+	}
+
+	return profiles, nil
+}
+
+// fetchRemainingSections pages in the experience and education entities a profile response
+// left out, appending them to apiResponse.Included so the usual parsing picks them up.
+// Sections are fetched until the count reported in their paging is reached or a page adds
+// nothing new.
+func (c *Client) fetchRemainingSections(ctx context.Context, apiResponse *ProfileAPIResponse, publicIdentifier string) error {
+	profileEntity, err := findProfileEntity(apiResponse, publicIdentifier)
+	if err != nil {
+		return nil // Conversion reports the missing profile
+	}
+	profileURN := profileEntity.EntityURN
+	sections := []struct {
+		sectionType string
+		entityType  string
+		paging      *PagingInfoResponse
+	}{
+		{"experience", EntityTypePosition, nil},
+		{"education", EntityTypeEducation, nil},
+	}
+	if profileEntity.ProfilePositions != nil {
+		sections[0].paging = profileEntity.ProfilePositions.Paging
+	}
+	if profileEntity.ProfileEducations != nil {
+		sections[1].paging = profileEntity.ProfileEducations.Paging
+	}
+
+	known := make(map[string]bool, len(apiResponse.Included))
+	for _, item := range apiResponse.Included {
+		known[item.EntityURN] = true
+	}
+	customHeaders := c.profileRequestHeaders(publicIdentifier)
+
+	for _, section := range sections {
+		if section.paging == nil {
+			continue
+		}
+		have := 0
+		for _, item := range apiResponse.Included {
+			if item.Type == section.entityType {
+				have++
+			}
+		}
+		for have < section.paging.Total {
+			variablesString := fmt.Sprintf("(profileUrn:%s,sectionType:%s,start:%d,count:%d)",
+				escapeRestliString(profileURN), section.sectionType, have, ProfileSectionPageSize)
+			requestURL, err := buildProfileVariablesURL(VoyagerBaseURL, DefaultProfileComponentsQueryID, variablesString)
+			if err != nil {
+				return fmt.Errorf("%w: %v", ErrRequestBuildFailed, err)
+			}
+			page, err := c.fetchProfileResponse(ctx, requestURL, customHeaders)
+			if err != nil {
+				return fmt.Errorf("failed to fetch %s page: %w", section.sectionType, err)
+			}
 
+			added := 0
+			for _, item := range page.Included {
+				if item.EntityURN != "" && known[item.EntityURN] {
+					continue
+				}
+				known[item.EntityURN] = true
+				apiResponse.Included = append(apiResponse.Included, item)
+				if item.Type == section.entityType {
+					added++
+				}
+			}
+			if added == 0 {
+				break
+			}
+			have += added
+		}
+		c.logDebug("fetched profile section pages", "section", section.sectionType, "entries", have, "total", section.paging.Total)
+	}
+	return nil
+}
+
+// fetchProfileResponse performs a profile request and decodes the response.
+func (c *Client) fetchProfileResponse(ctx context.Context, requestURL string, customHeaders http.Header) (*ProfileAPIResponse, error) {
 	// Make API Call
 	resp, respBodyBytes, err := c.makeRequest(ctx, http.MethodGet, requestURL, customHeaders, nil)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrRequestFailed, err)
+		return nil, fmt.Errorf("%w: %w", ErrRequestFailed, err)
 	}
 
 	// Error Handling (HTTP Status)
-	if resp.StatusCode != http.StatusOK {
-		switch resp.StatusCode {
-		case http.StatusUnauthorized, http.StatusForbidden:
-			return nil, fmt.Errorf("%w: status %d, body: %s", ErrUnauthorized, resp.StatusCode, string(respBodyBytes))
-		case http.StatusTooManyRequests:
-			return nil, fmt.Errorf("%w: status %d, body: %s", ErrRateLimited, resp.StatusCode, string(respBodyBytes))
-		default:
-			return nil, fmt.Errorf("%w: received status code %d, body: %s", ErrRequestFailed, resp.StatusCode, string(respBodyBytes))
-		}
+	if err := statusError(resp, respBodyBytes); err != nil {
+		return nil, err
 	}
 
 	// Parse JSON Response
 	var apiResponse ProfileAPIResponse
-	err = json.Unmarshal(respBodyBytes, &apiResponse)
+	err = c.decodeResponse(respBodyBytes, &apiResponse)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v. Raw response: %s", ErrResponseParseFailed, err, string(respBodyBytes))
+		return nil, fmt.Errorf("%w: %s: %v. Raw response: %s", ErrResponseParseFailed, sanitizeURL(requestURL), err, string(respBodyBytes))
 	}
 
-	// Extract Profile from Response using comprehensive parsing
-	profile, err := convertAPIResponseToLinkedInProfile(&apiResponse, publicIdentifier)
-	if err != nil {
-		return nil, fmt.Errorf("failed to extract profile from response: %w", err)
+	return &apiResponse, nil
+}
+
+// withQueryIDFallback calls fetch with each query ID in order, moving on to the next one
+// only when LinkedIn reports the current ID as deprecated. It returns the first success,
+// the first non-deprecation error, or the last deprecation error when all IDs fail.
+func withQueryIDFallback[T any](queryIDs []string, fetch func(queryID string) (T, error)) (T, error) {
+	var result T
+	var err error
+	for _, queryID := range queryIDs {
+		result, err = fetch(queryID)
+		if !errors.Is(err, ErrQueryIDDeprecated) {
+			return result, err
+		}
 	}
+	return result, err
+}
 
-	return profile, nil
+// queryIDDeprecationMarkers are lowercase body fragments LinkedIn returns when a
+// persisted GraphQL query ID has been rotated out.
+var queryIDDeprecationMarkers = []string{
+	"persistedquerynotfound",
+	"persisted query not found",
+	"invalid queryid",
+	"unknown queryid",
+}
+
+// statusError maps a non-200 response to the package's sentinel errors, or returns nil.
+// The error names the sanitized request URL so failures can be reproduced from logs.
+func statusError(resp *http.Response, respBodyBytes []byte) error {
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	requestURL := responseURL(resp)
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusNotFound {
+		lowerBody := strings.ToLower(string(respBodyBytes))
+		for _, marker := range queryIDDeprecationMarkers {
+			if strings.Contains(lowerBody, marker) {
+				return fmt.Errorf("%w: %s: status %d, body: %s", ErrQueryIDDeprecated, requestURL, resp.StatusCode, string(respBodyBytes))
+			}
+		}
+	}
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("%w: %s: status %d, body: %s", ErrUnauthorized, requestURL, resp.StatusCode, string(respBodyBytes))
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("%w: %s: status %d, body: %s", ErrRateLimited, requestURL, resp.StatusCode, string(respBodyBytes))
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %w: %s: status %d, body: %s", ErrRequestFailed, ErrNotFound, requestURL, resp.StatusCode, string(respBodyBytes))
+	default:
+		return fmt.Errorf("%w: %s: received status code %d, body: %s", ErrRequestFailed, requestURL, resp.StatusCode, string(respBodyBytes))
+	}
+}
+
+// responseURL returns the sanitized URL of the request that produced resp.
+func responseURL(resp *http.Response) string {
+	if resp.Request == nil || resp.Request.URL == nil {
+		return ""
+	}
+	return sanitizeURL(resp.Request.URL.String())
+}
+
+// requestError prefixes err with the method and sanitized URL of the failed request.
+// Credentials travel in headers, so the URL is safe to log once sensitive query
+// parameters are redacted.
+func requestError(method, urlStr string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s %s: %w", method, sanitizeURL(urlStr), err)
 }
 
 // makeRequest executes an HTTP request and returns the response and body bytes.
-// It handles adding common headers like CSRF token and li_at cookie.
+// It handles adding common headers like CSRF token and li_at cookie, and retries
+// retryable failures up to Config.MaxRetries times. The body is buffered up front
+// so every attempt sends identical bytes. When ctx has no deadline, Config.DefaultRequestTimeout
+// bounds the whole call, retries included.
 func (c *Client) makeRequest(ctx context.Context, method string, urlStr string, headers http.Header, body io.Reader) (*http.Response, []byte, error) {
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline && c.config.DefaultRequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.DefaultRequestTimeout)
+		defer cancel()
+	}
+
+	var bodyBytes []byte
+	if body != nil {
+		var err error
+		bodyBytes, err = io.ReadAll(body)
+		if err != nil {
+			return nil, nil, requestError(method, urlStr, fmt.Errorf("failed to read request body: %w", err))
+		}
+	}
+
+	// Compress large bodies once; every attempt then replays the same compressed bytes.
+	if c.config.CompressRequests && len(bodyBytes) > RequestCompressionThreshold {
+		compressed, err := gzipBytes(bodyBytes)
+		if err != nil {
+			return nil, nil, requestError(method, urlStr, fmt.Errorf("failed to compress request body: %w", err))
+		}
+		bodyBytes = compressed
+		headers = headers.Clone()
+		if headers == nil {
+			headers = http.Header{}
+		}
+		headers.Set("Content-Encoding", "gzip")
+	}
+
+	if pemMetadata := c.config.PemMetadata[operationFromContext(ctx)]; pemMetadata != "" {
+		headers = headers.Clone()
+		if headers == nil {
+			headers = http.Header{}
+		}
+		headers.Set("X-Li-Pem-Metadata", pemMetadata)
+	}
+
+	// Capture the credentials once so a concurrent rotation cannot change them between attempts.
+	auth := c.activeAuth()
+
+	// Generate the correlation ID once so all attempts of a request share it.
+	if c.requestIDGenerator != nil {
+		if requestID := c.requestIDGenerator(); requestID != "" {
+			headers = headers.Clone()
+			if headers == nil {
+				headers = http.Header{}
+			}
+			headers.Set("X-Request-ID", requestID)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, respBodyBytes, err := c.doRequest(ctx, method, urlStr, headers, bodyBytes, auth)
+		c.archiveResponse(ctx, urlStr, respBodyBytes)
+		if attempt >= c.config.MaxRetries || !c.retriesMethod(method) || !isRetryable(resp, err) || ctx.Err() != nil {
+			return resp, respBodyBytes, requestError(method, urlStr, err)
+		}
+
+		select {
+		case <-time.After(c.retryDelay(attempt)):
+		case <-ctx.Done():
+			return resp, respBodyBytes, requestError(method, urlStr, err)
+		}
+	}
+}
+
+// gzipBytes returns data gzip-compressed.
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// retriesMethod reports whether requests with the given method may be retried. Idempotent
+// methods always may; POST and PATCH only with Config.AllowPostRetry.
+func (c *Client) retriesMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPatch:
+		return c.config.AllowPostRetry
+	}
+	return true
+}
+
+// retryDelay returns the wait before retry attempt+1: Config.RetryBackoff doubled per
+// attempt, capped at MaxRetryBackoff. Doubling stops at the cap, so large attempt counts
+// cannot overflow into a zero or negative delay.
+func (c *Client) retryDelay(attempt int) time.Duration {
+	delay := c.config.RetryBackoff
+	if delay <= 0 {
+		delay = DefaultRetryBackoff
+	}
+	for range attempt {
+		if delay >= MaxRetryBackoff/2 {
+			return MaxRetryBackoff
+		}
+		delay *= 2
+	}
+	return min(delay, MaxRetryBackoff)
+}
+
+// isRetryable reports whether a failed attempt is worth repeating: transport errors,
+// rate limiting and transient server errors.
+func isRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// doRequest performs a single HTTP attempt for makeRequest, authenticated with auth.
+func (c *Client) doRequest(ctx context.Context, method string, urlStr string, headers http.Header, bodyBytes []byte, auth AuthCredentials) (*http.Response, []byte, error) {
 	// log.Printf("[DEBUG] makeRequest (from Echo example context): URL: %s", urlStr) // TEMPORARY LOGGING - REMOVED
+	var body io.Reader
+	if bodyBytes != nil {
+		body = bytes.NewReader(bodyBytes)
+	}
 	req, err := http.NewRequestWithContext(ctx, method, urlStr, body)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create request: %w", err)
@@ -210,19 +978,19 @@ func (c *Client) makeRequest(ctx context.Context, method string, urlStr string,
 	// but if we were sending a POST with a JSON body, it would be "application/json".
 	// req.Header.Set("Content-Type", "application/json") // Not for GET
 
-	// Set User-Agent to match the cURL
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/136.0.0.0 Safari/537.36")
-	req.Header.Set("Accept-Language", "en-GB,en-US;q=0.9,en;q=0.8")
-	req.Header.Set("Accept-Encoding", "gzip, deflate, br, zstd")
-	req.Header.Set("X-Li-Lang", "en_US")
+	req.Header.Set("User-Agent", c.config.userAgent())
+	req.Header.Set("Accept-Language", c.config.acceptLanguage())
+	req.Header.Set("Accept-Encoding", AcceptEncodingHeaderValue)
+	req.Header.Set("X-Li-Lang", c.config.liLang())
 	req.Header.Set("X-Restli-Protocol-Version", "2.0.0")
 
 	// Add CSRF token and li_at cookie
-	req.Header.Set("Csrf-Token", c.config.Auth.CSRFToken)
-	req.Header.Set("Cookie", fmt.Sprintf("li_at=%s; JSESSIONID=\"%s\"", c.config.Auth.LiAtCookie, c.config.Auth.JSESSIONID))
+	req.Header.Set("Csrf-Token", auth.CSRFToken)
+	req.Header.Set("Cookie", fmt.Sprintf("li_at=%s; JSESSIONID=\"%s\"", auth.LiAtCookie, auth.JSESSIONID))
 
-	// Add any other headers passed in the headers argument
+	// Add any other headers passed in the headers argument, replacing the defaults above
 	for key, values := range headers {
+		req.Header.Del(key)
 		for _, value := range values {
 			req.Header.Add(key, value)
 		}
@@ -236,27 +1004,108 @@ func (c *Client) makeRequest(ctx context.Context, method string, urlStr string,
 	// 	} // TEMPORARY LOGGING - REMOVED
 	// } // TEMPORARY LOGGING - REMOVED
 
+	if c.throttle != nil {
+		if err := c.throttle.wait(ctx, c.egressKey(req)); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		// The client echoes the raw URL in its error; redact it like the outer wrapping does.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			urlErr.URL = sanitizeURL(urlErr.URL)
+		}
 		return nil, nil, fmt.Errorf("http client failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
+	c.recordRateLimit(resp, c.now())
+
+	respBodyBytes, compressedBytes, err := readResponseBody(resp)
+	if err != nil {
+		// The body is partly consumed, so the response is of no use to callers
+		return nil, nil, err
+	}
+	c.recordResponseMetrics(ctx, urlStr, resp, int64(len(respBodyBytes)), compressedBytes)
+
+	return resp, respBodyBytes, nil
+}
+
+// responseDecoders opens a decompressor for each Content-Encoding the client accepts in
+// AcceptEncodingHeaderValue. HTTP's "deflate" is zlib-wrapped.
+var responseDecoders = map[string]func(r io.Reader) (io.ReadCloser, error){
+	"gzip": func(r io.Reader) (io.ReadCloser, error) {
+		return gzip.NewReader(r)
+	},
+	"deflate": func(r io.Reader) (io.ReadCloser, error) {
+		return zlib.NewReader(r)
+	},
+	"br": func(r io.Reader) (io.ReadCloser, error) {
+		return io.NopCloser(brotli.NewReader(r)), nil
+	},
+	"zstd": func(r io.Reader) (io.ReadCloser, error) {
+		decoder, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return decoder.IOReadCloser(), nil
+	},
+}
+
+// readResponseBody reads resp's body, decompressing it per its Content-Encoding: since the
+// client sets Accept-Encoding itself, Go's transport leaves decompression to us. The
+// decompressor is released on every path; the caller closes the body. A body that cannot
+// be decompressed or read in full yields ErrIncompleteResponse. Alongside the body it
+// returns the compressed size when it decompressed one, zero otherwise.
+func readResponseBody(resp *http.Response) ([]byte, int64, error) {
 	var reader io.Reader = resp.Body
-	// Check if the server sent gzipped content, even if Go's client is supposed to handle it.
-	if resp.Header.Get("Content-Encoding") == "gzip" {
-		gzipReader, err := gzip.NewReader(resp.Body)
+	var compressed *countingReader
+	encoding := strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding")))
+	if newDecoder, ok := responseDecoders[encoding]; ok {
+		compressed = &countingReader{r: resp.Body}
+		decoder, err := newDecoder(compressed)
 		if err != nil {
-			return resp, nil, fmt.Errorf("failed to create gzip reader for response body: %w", err)
+			return nil, 0, fmt.Errorf("%w: invalid %s response body: %v", ErrIncompleteResponse, encoding, err)
 		}
-		defer gzipReader.Close()
-		reader = gzipReader
+		defer decoder.Close()
+		reader = decoder
 	}
 
-	respBodyBytes, err := io.ReadAll(reader) // Read from the (potentially decompressed) reader
+	respBodyBytes, err := io.ReadAll(reader)
 	if err != nil {
-		return resp, nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, 0, fmt.Errorf("%w: failed to read response body: %v", ErrIncompleteResponse, err)
+	}
+	if compressed == nil {
+		return respBodyBytes, 0, nil
 	}
+	return respBodyBytes, compressed.n, nil
+}
 
-	return resp, respBodyBytes, nil
+// hashedHeaders lists the request headers that influence LinkedIn's response and are
+// therefore part of RequestHash. Credentials and per-call IDs are deliberately excluded.
+var hashedHeaders = []string{
+	"Accept",
+	"Accept-Language",
+	"X-Li-Lang",
+	"X-Restli-Protocol-Version",
+}
+
+// RequestHash returns a stable hex-encoded SHA-256 of a request's method, URL,
+// response-relevant headers and body. Identical logical requests hash identically
+// regardless of credentials or correlation IDs, which makes the hash suitable as a
+// cache key or for detecting duplicate retries.
+func RequestHash(method, urlStr string, headers http.Header, body []byte) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n", strings.ToUpper(method), urlStr)
+	for _, name := range hashedHeaders {
+		values := headers.Values(name)
+		if len(values) == 0 {
+			continue
+		}
+		fmt.Fprintf(h, "%s:%s\n", strings.ToLower(name), strings.Join(values, ","))
+	}
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
 }
@@ -0,0 +1,205 @@
+package linkedinscraper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// companyURNNamespaces are the URN namespaces that identify a company by its numeric ID,
+// e.g. the "*company" reference of an Experience.
+var companyURNNamespaces = []string{"urn:li:fsd_company:", "urn:li:fs_normalized_company:", "urn:li:company:"}
+
+// ResolveCompanyUniversalName returns the universal name of a company, the vanity part of
+// its page URL ("acme-capital" in https://www.linkedin.com/company/acme-capital/), given a
+// company URN such as Experience.CompanyURN. Results are cached for the lifetime of the
+// client, so repeated lookups of the same company cost one request. A company LinkedIn
+// does not return yields ErrNotFound.
+func (c *Client) ResolveCompanyUniversalName(ctx context.Context, companyURN string) (string, error) {
+	ctx = withOperation(ctx, "ResolveCompanyUniversalName")
+
+	// Input Validation
+	if c.config.Auth.LiAtCookie == "" || c.config.Auth.CSRFToken == "" {
+		return "", ErrAuthMissing
+	}
+	companyID := companyIDFromURN(companyURN)
+	if companyID == "" {
+		return "", fmt.Errorf("%w: %q", ErrInvalidURN, companyURN)
+	}
+
+	c.mu.Lock()
+	cached, ok := c.companyNames[companyID]
+	c.mu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	// Build URL
+	variablesString := fmt.Sprintf("(companyUrns:List(%s))", escapeRestliString("urn:li:fsd_company:"+companyID))
+	requestURL, err := buildProfileVariablesURL(VoyagerBaseURL, DefaultCompanyQueryID, variablesString)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrRequestBuildFailed, err)
+	}
+
+	// Prepare Headers
+	customHeaders := http.Header{}
+	customHeaders.Set("Accept", AcceptHeaderValue)
+	customHeaders.Set("X-Li-Pem-Metadata", "Voyager - Organization - Member=organization-page")
+
+	// Make API Call
+	resp, respBodyBytes, err := c.makeRequest(ctx, http.MethodGet, requestURL, customHeaders, nil)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrRequestFailed, err)
+	}
+
+	// Error Handling (HTTP Status)
+	if err := statusError(resp, respBodyBytes); err != nil {
+		return "", err
+	}
+
+	// Parse JSON Response
+	var apiResponse CompanyAPIResponse
+	if err := c.decodeResponse(respBodyBytes, &apiResponse); err != nil {
+		return "", fmt.Errorf("%w: %v. Raw response: %s", ErrResponseParseFailed, err, string(respBodyBytes))
+	}
+
+	for _, item := range apiResponse.Included {
+		if item.Type == EntityTypeCompany && companyIDFromURN(item.EntityURN) == companyID && item.UniversalName != "" {
+			c.mu.Lock()
+			if c.companyNames == nil {
+				c.companyNames = make(map[string]string)
+			}
+			c.companyNames[companyID] = item.UniversalName
+			c.mu.Unlock()
+			return item.UniversalName, nil
+		}
+	}
+	return "", fmt.Errorf("%w: company %s", ErrNotFound, companyURN)
+}
+
+// GetCompanyInsights fetches the Premium insights of a company given its URN, such as
+// Experience.CompanyURN: employee count, six-month headcount growth, median tenure and
+// the headcount per job function, each where LinkedIn reports it. Accounts without
+// Premium get ErrPremiumRequired; a company LinkedIn returns no insights for yields
+// ErrNotFound. The query IDs in Config.CompanyInsightsQueryIDs are tried in order.
+func (c *Client) GetCompanyInsights(ctx context.Context, companyURN string) (*CompanyInsights, error) {
+	ctx = withOperation(ctx, "GetCompanyInsights")
+
+	// Input Validation
+	if c.config.Auth.LiAtCookie == "" || c.config.Auth.CSRFToken == "" {
+		return nil, ErrAuthMissing
+	}
+	companyID := companyIDFromURN(companyURN)
+	if companyID == "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidURN, companyURN)
+	}
+
+	variablesString := fmt.Sprintf("(company:%s)", escapeRestliString("urn:li:fsd_company:"+companyID))
+
+	// Prepare Headers
+	customHeaders := http.Header{}
+	customHeaders.Set("Accept", AcceptHeaderValue)
+	customHeaders.Set("X-Li-Pem-Metadata", "Voyager - Premium - Company Insights=company-insights")
+
+	// Try each configured query ID in order, falling back when LinkedIn reports one as deprecated
+	apiResponse, err := withQueryIDFallback(c.config.companyInsightsQueryIDs(), func(queryID string) (*CompanyInsightsAPIResponse, error) {
+		// Build URL
+		requestURL, err := buildProfileVariablesURL(VoyagerBaseURL, queryID, variablesString)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrRequestBuildFailed, err)
+		}
+
+		// Make API Call
+		resp, respBodyBytes, err := c.makeRequest(ctx, http.MethodGet, requestURL, customHeaders, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrRequestFailed, err)
+		}
+
+		// Error Handling (HTTP Status); LinkedIn refuses non-Premium members with a 403
+		if resp.StatusCode == http.StatusForbidden && strings.Contains(strings.ToLower(string(respBodyBytes)), "premium") {
+			return nil, fmt.Errorf("%w: company insights for %s", ErrPremiumRequired, companyURN)
+		}
+		if err := statusError(resp, respBodyBytes); err != nil {
+			return nil, err
+		}
+
+		// Parse JSON Response
+		var apiResponse CompanyInsightsAPIResponse
+		if err := c.decodeResponse(respBodyBytes, &apiResponse); err != nil {
+			return nil, fmt.Errorf("%w: %v. Raw response: %s", ErrResponseParseFailed, err, string(respBodyBytes))
+		}
+		return &apiResponse, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCompanyInsights(apiResponse, "urn:li:fsd_company:"+companyID)
+}
+
+// parseCompanyInsights extracts the insights of companyURN from a company insights response.
+// Insights entities are keyed by company ID, e.g. "urn:li:fsd_companyInsights:1001"; those
+// of other companies, such as similar-company cards, are skipped.
+func parseCompanyInsights(apiResponse *CompanyInsightsAPIResponse, companyURN string) (*CompanyInsights, error) {
+	companyID := companyIDFromURN(companyURN)
+	premiumRequired := false
+	for _, item := range apiResponse.Included {
+		switch item.Type {
+		case EntityTypePremiumUpsell:
+			premiumRequired = true
+		case EntityTypeCompanyInsights:
+			if item.EntityURN[strings.LastIndex(item.EntityURN, ":")+1:] != companyID {
+				continue
+			}
+			insights := &CompanyInsights{
+				CompanyURN:   companyURN,
+				MedianTenure: item.MedianTenureYears,
+			}
+			if headcount := item.HeadcountInsights; headcount != nil {
+				insights.EmployeeCount = int(headcount.TotalEmployees)
+				for _, period := range headcount.GrowthPeriods {
+					if period.MonthDifference == 6 {
+						growth := period.ChangePercentage
+						insights.EmployeeGrowth6Mo = &growth
+					}
+				}
+			}
+			if functions := item.FunctionHeadcountInsights; functions != nil {
+				for _, function := range functions.LatestHeadcountByFunction {
+					insights.FunctionBreakdown = append(insights.FunctionBreakdown, FunctionHeadcount{
+						Function:   function.FunctionName,
+						Count:      int(function.FunctionCount),
+						Percentage: function.FunctionPercentage,
+					})
+				}
+				sort.SliceStable(insights.FunctionBreakdown, func(i, j int) bool {
+					return insights.FunctionBreakdown[i].Count > insights.FunctionBreakdown[j].Count
+				})
+			}
+			return insights, nil
+		}
+	}
+
+	if premiumRequired {
+		return nil, fmt.Errorf("%w: company insights for %s", ErrPremiumRequired, companyURN)
+	}
+	return nil, fmt.Errorf("%w: company insights for %s", ErrNotFound, companyURN)
+}
+
+// companyIDFromURN returns the numeric ID of a company URN in any of the
+// companyURNNamespaces, or "" when urn is not one.
+func companyIDFromURN(urn string) string {
+	for _, namespace := range companyURNNamespaces {
+		id, ok := strings.CutPrefix(urn, namespace)
+		if !ok {
+			continue
+		}
+		if id == "" || strings.Trim(id, "0123456789") != "" {
+			return ""
+		}
+		return id
+	}
+	return ""
+}
@@ -0,0 +1,170 @@
+package linkedinscraper_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("ResolveCompanyUniversalName", func() {
+	companyResponse := []byte(`{
+		"data": {"data": {"organizationDashCompaniesByIds": {"*elements": ["urn:li:fsd_company:1001"]}}},
+		"included": [
+			{
+				"$type": "com.linkedin.voyager.dash.organization.Company",
+				"entityUrn": "urn:li:fsd_company:1001",
+				"name": "Acme Capital",
+				"universalName": "acme-capital"
+			}
+		]
+	}`)
+
+	It("returns the universal name and serves repeat lookups from the cache", func() {
+		transport := newFakeTransport(http.StatusOK, companyResponse)
+		client := newTestClient(transport)
+
+		name, err := client.ResolveCompanyUniversalName(context.Background(), "urn:li:fsd_company:1001")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("acme-capital"))
+		Expect(transport.Requests()[0].URL.RawQuery).To(ContainSubstring("queryId=" + linkedinscraper.DefaultCompanyQueryID))
+		Expect(transport.Requests()[0].URL.RawQuery).To(ContainSubstring("variables=(companyUrns:List(urn%3Ali%3Afsd_company%3A1001))"))
+
+		name, err = client.ResolveCompanyUniversalName(context.Background(), "urn:li:company:1001")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("acme-capital"))
+		Expect(transport.Requests()).To(HaveLen(1))
+	})
+
+	It("does not cache companies LinkedIn did not return", func() {
+		transport := newFakeTransport(http.StatusOK, []byte(`{"included":[]}`))
+		client := newTestClient(transport)
+
+		for range 2 {
+			_, err := client.ResolveCompanyUniversalName(context.Background(), "urn:li:fsd_company:404")
+			Expect(err).To(MatchError(linkedinscraper.ErrNotFound))
+		}
+		Expect(transport.Requests()).To(HaveLen(2))
+	})
+
+	It("rejects URNs that are not company URNs without a request", func() {
+		transport := newFakeTransport(http.StatusOK, companyResponse)
+
+		_, err := newTestClient(transport).ResolveCompanyUniversalName(context.Background(), "urn:li:fsd_profile:ACoAAAJaneDoe")
+		Expect(err).To(MatchError(linkedinscraper.ErrInvalidURN))
+		Expect(transport.Requests()).To(BeEmpty())
+	})
+})
+
+var _ = Describe("GetCompanyInsights", func() {
+	It("parses headcount, growth, tenure and the function breakdown", func() {
+		transport := newFakeTransport(http.StatusOK, loadFixture("company_insights.json"))
+
+		insights, err := newTestClient(transport).GetCompanyInsights(context.Background(), "urn:li:company:1001")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(insights.CompanyURN).To(Equal("urn:li:fsd_company:1001"))
+		Expect(insights.EmployeeCount).To(Equal(1250))
+		Expect(insights.EmployeeGrowth6Mo).To(HaveValue(BeNumerically("~", 12.5)))
+		Expect(insights.MedianTenure).To(BeNumerically("~", 2.4))
+		Expect(insights.FunctionBreakdown).To(Equal([]linkedinscraper.FunctionHeadcount{
+			{Function: "Engineering", Count: 405, Percentage: 32.4},
+			{Function: "Sales", Count: 310, Percentage: 24.8},
+			{Function: "Operations", Count: 120, Percentage: 9.6},
+		}))
+
+		Expect(transport.Requests()[0].URL.RawQuery).To(ContainSubstring("queryId=" + linkedinscraper.DefaultCompanyInsightsQueryID))
+		Expect(transport.Requests()[0].URL.RawQuery).To(ContainSubstring("variables=(company:urn%3Ali%3Afsd_company%3A1001)"))
+	})
+
+	It("falls back to the next configured query ID", func() {
+		transport := &fakeTransport{handler: func(req *http.Request) *http.Response {
+			if strings.Contains(req.URL.RawQuery, "queryId=old.1") {
+				return newResponse(http.StatusBadRequest, []byte(`{"errors":[{"message":"PersistedQueryNotFound"}]}`))
+			}
+			return newResponse(http.StatusOK, loadFixture("company_insights.json"))
+		}}
+		client := newTestClient(transport, func(cfg *linkedinscraper.Config) {
+			cfg.CompanyInsightsQueryIDs = []string{"old.1", "new.2"}
+		})
+
+		insights, err := client.GetCompanyInsights(context.Background(), "urn:li:fsd_company:1001")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(insights.EmployeeCount).To(Equal(1250))
+
+		requests := transport.Requests()
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[1].URL.RawQuery).To(ContainSubstring("queryId=new.2"))
+	})
+
+	It("leaves growth unset when LinkedIn reports no six-month period", func() {
+		transport := newFakeTransport(http.StatusOK, []byte(`{"included":[{
+			"$type": "com.linkedin.voyager.dash.premium.companyinsights.CompanyInsights",
+			"entityUrn": "urn:li:fsd_companyInsights:1001",
+			"headcountInsights": {"totalEmployees": 40, "growthPeriods": [{"monthDifference": 12, "changePercentage": -5}]}
+		}]}`))
+
+		insights, err := newTestClient(transport).GetCompanyInsights(context.Background(), "urn:li:fsd_company:1001")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(insights.EmployeeCount).To(Equal(40))
+		Expect(insights.EmployeeGrowth6Mo).To(BeNil())
+		Expect(insights.FunctionBreakdown).To(BeEmpty())
+	})
+
+	It("reports ErrPremiumRequired for the upsell LinkedIn shows without Premium", func() {
+		transport := newFakeTransport(http.StatusOK, loadFixture("company_insights_upsell.json"))
+
+		_, err := newTestClient(transport).GetCompanyInsights(context.Background(), "urn:li:fsd_company:1001")
+		Expect(err).To(MatchError(linkedinscraper.ErrPremiumRequired))
+	})
+
+	It("reports ErrPremiumRequired for a Premium-only 403", func() {
+		transport := newFakeTransport(http.StatusForbidden, []byte(`{"message":"This feature requires LinkedIn Premium","status":403}`))
+
+		_, err := newTestClient(transport).GetCompanyInsights(context.Background(), "urn:li:fsd_company:1001")
+		Expect(err).To(MatchError(linkedinscraper.ErrPremiumRequired))
+		Expect(err).NotTo(MatchError(linkedinscraper.ErrUnauthorized))
+	})
+
+	It("skips the insights of other companies", func() {
+		transport := newFakeTransport(http.StatusOK, []byte(`{"included":[{
+			"$type": "com.linkedin.voyager.dash.premium.companyinsights.CompanyInsights",
+			"entityUrn": "urn:li:fsd_companyInsights:2002",
+			"headcountInsights": {"totalEmployees": 9}
+		}, {
+			"$type": "com.linkedin.voyager.dash.premium.companyinsights.CompanyInsights",
+			"entityUrn": "urn:li:fsd_companyInsights:1001",
+			"headcountInsights": {"totalEmployees": 40}
+		}]}`))
+
+		insights, err := newTestClient(transport).GetCompanyInsights(context.Background(), "urn:li:fsd_company:1001")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(insights.EmployeeCount).To(Equal(40))
+
+		transport = newFakeTransport(http.StatusOK, []byte(`{"included":[{
+			"$type": "com.linkedin.voyager.dash.premium.companyinsights.CompanyInsights",
+			"entityUrn": "urn:li:fsd_companyInsights:2002",
+			"headcountInsights": {"totalEmployees": 9}
+		}]}`))
+		_, err = newTestClient(transport).GetCompanyInsights(context.Background(), "urn:li:fsd_company:1001")
+		Expect(err).To(MatchError(linkedinscraper.ErrNotFound))
+	})
+
+	It("returns ErrNotFound when the response holds no insights", func() {
+		transport := newFakeTransport(http.StatusOK, []byte(`{"included":[]}`))
+
+		_, err := newTestClient(transport).GetCompanyInsights(context.Background(), "urn:li:fsd_company:1001")
+		Expect(err).To(MatchError(linkedinscraper.ErrNotFound))
+	})
+
+	It("rejects URNs that are not company URNs without a request", func() {
+		transport := newFakeTransport(http.StatusOK, loadFixture("company_insights.json"))
+
+		_, err := newTestClient(transport).GetCompanyInsights(context.Background(), "urn:li:fsd_profile:ACoAAAJaneDoe")
+		Expect(err).To(MatchError(linkedinscraper.ErrInvalidURN))
+		Expect(transport.Requests()).To(BeEmpty())
+	})
+})
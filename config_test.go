@@ -0,0 +1,114 @@
+package linkedinscraper_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("NewConfig", func() {
+	DescribeTable("derives the CSRF token from JSESSIONID",
+		func(jsessionID string) {
+			cfg, err := linkedinscraper.NewConfig(linkedinscraper.AuthCredentials{
+				LiAtCookie: "test-li-at",
+				JSESSIONID: jsessionID,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Auth.CSRFToken).To(Equal("ajax:123"))
+			Expect(cfg.Auth.JSESSIONID).To(Equal("ajax:123"))
+		},
+		Entry("unquoted", "ajax:123"),
+		Entry("quoted as in the cookie", `"ajax:123"`),
+		Entry("quoted with surrounding whitespace", ` "ajax:123" `),
+	)
+
+	It("keeps an explicit CSRF token", func() {
+		cfg, err := linkedinscraper.NewConfig(linkedinscraper.AuthCredentials{
+			LiAtCookie: "test-li-at",
+			CSRFToken:  "ajax:explicit",
+			JSESSIONID: "ajax:123",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Auth.CSRFToken).To(Equal("ajax:explicit"))
+	})
+
+	It("still requires a CSRF token or JSESSIONID", func() {
+		_, err := linkedinscraper.NewConfig(linkedinscraper.AuthCredentials{LiAtCookie: "test-li-at"})
+		Expect(err).To(MatchError(linkedinscraper.ErrAuthMissing))
+	})
+})
+
+var _ = Describe("ConfigFromEnv", func() {
+	BeforeEach(func() {
+		for _, name := range []string{
+			linkedinscraper.EnvLiAt,
+			linkedinscraper.EnvCSRFToken,
+			linkedinscraper.EnvJSESSIONID,
+			linkedinscraper.EnvProxyURL,
+			linkedinscraper.EnvUserAgent,
+		} {
+			GinkgoT().Setenv(name, "")
+		}
+	})
+
+	It("reads credentials and optional settings", func() {
+		GinkgoT().Setenv("LINKEDIN_LI_AT", "env-li-at")
+		GinkgoT().Setenv("LINKEDIN_CSRF_TOKEN", "ajax:env-csrf")
+		GinkgoT().Setenv("LINKEDIN_JSESSIONID", `"ajax:env-csrf"`)
+		GinkgoT().Setenv("LINKEDIN_PROXY_URL", "http://proxy.example.com:8080")
+		GinkgoT().Setenv("LINKEDIN_USER_AGENT", "test-agent/1.0")
+
+		cfg, err := linkedinscraper.ConfigFromEnv()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Auth.LiAtCookie).To(Equal("env-li-at"))
+		Expect(cfg.Auth.CSRFToken).To(Equal("ajax:env-csrf"))
+		Expect(cfg.Auth.JSESSIONID).To(Equal("ajax:env-csrf"))
+		Expect(cfg.ProxyURL).To(Equal("http://proxy.example.com:8080"))
+		Expect(cfg.UserAgent).To(Equal("test-agent/1.0"))
+
+		transport := newFakeTransport(http.StatusOK, loadFixture("profile.json"))
+		client, err := linkedinscraper.NewClient(cfg, linkedinscraper.WithHTTPClient(&http.Client{Transport: transport}))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.GetProfile(context.Background(), "jane-doe")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(transport.Requests()[0].Header.Get("User-Agent")).To(Equal("test-agent/1.0"))
+	})
+
+	It("derives the CSRF token from LINKEDIN_JSESSIONID and defaults the user agent", func() {
+		GinkgoT().Setenv("LINKEDIN_LI_AT", "env-li-at")
+		GinkgoT().Setenv("LINKEDIN_JSESSIONID", "ajax:456")
+
+		cfg, err := linkedinscraper.ConfigFromEnv()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Auth.CSRFToken).To(Equal("ajax:456"))
+		Expect(cfg.UserAgent).To(Equal(linkedinscraper.DefaultUserAgent))
+		Expect(cfg.ProxyURL).To(BeEmpty())
+	})
+
+	DescribeTable("returns ErrAuthMissing when required variables are absent",
+		func(liAt, csrf string) {
+			GinkgoT().Setenv("LINKEDIN_LI_AT", liAt)
+			GinkgoT().Setenv("LINKEDIN_CSRF_TOKEN", csrf)
+
+			_, err := linkedinscraper.ConfigFromEnv()
+			Expect(err).To(MatchError(linkedinscraper.ErrAuthMissing))
+		},
+		Entry("no li_at", "", "ajax:env-csrf"),
+		Entry("no CSRF token or JSESSIONID", "env-li-at", ""),
+	)
+
+	It("rejects an invalid proxy URL when building the client", func() {
+		GinkgoT().Setenv("LINKEDIN_LI_AT", "env-li-at")
+		GinkgoT().Setenv("LINKEDIN_JSESSIONID", "ajax:456")
+		GinkgoT().Setenv("LINKEDIN_PROXY_URL", "not a url")
+
+		cfg, err := linkedinscraper.ConfigFromEnv()
+		Expect(err).NotTo(HaveOccurred())
+		_, err = linkedinscraper.NewClient(cfg)
+		Expect(err).To(HaveOccurred())
+	})
+})
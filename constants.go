@@ -1,7 +1,14 @@
 package linkedinscraper
 
+import "time"
+
 const (
 	VoyagerBaseURL = "https://www.linkedin.com/voyager/api/graphql"
+	// VoyagerMeURL returns the authenticated member's mini profile; it is the cheapest
+	// authenticated call and is used to probe the session.
+	VoyagerMeURL = "https://www.linkedin.com/voyager/api/me"
+	// LinkedInFeedURL is the logged-in homepage, which issues a JSESSIONID to a valid li_at.
+	LinkedInFeedURL = "https://www.linkedin.com/feed/"
 	// DefaultSearchQueryID is the default query ID for profile searches.
 	// This was taken from a cURL command observation.
 	// Example: voyagerSearchDashClusters.b1d223dcc11b2a052b967900e7388211
@@ -12,11 +19,147 @@ const (
 	// This is used with the voyagerIdentityDashProfiles query to fetch detailed profile data.
 	DefaultProfileQueryID = "voyagerIdentityDashProfiles.8ca6ef03f32147a4d49324ed99a3d978"
 
+	// DefaultProfileTopCardQueryID is the default query ID for the top-card-only profile
+	// decoration GetProfileLite requests. Unverified: it has not been captured from live
+	// traffic yet, so GetProfileLite falls back to the full profile query IDs when
+	// LinkedIn rejects it.
+	DefaultProfileTopCardQueryID = "voyagerIdentityDashProfiles.a1a483e719b20537a256b6853cdca711"
+
+	// DefaultCompanyQueryID is the default query ID for fetching companies by URN.
+	DefaultCompanyQueryID = "voyagerOrganizationDashCompanies.148b1aebfadd0a455f32806df656c3c1"
+
+	// DefaultCompanyInsightsQueryID is the default query ID for the Premium company
+	// insights card: headcount, growth, tenure and function breakdown. Unverified: it has
+	// not been captured from live traffic yet; set Config.CompanyInsightsQueryIDs to a
+	// captured ID if LinkedIn rejects it.
+	DefaultCompanyInsightsQueryID = "voyagerPremiumDashCompanyInsightsCard.2b7c9a4e06f1d35c8e4a90b61d7f5e28"
+
+	// DefaultProfileComponentsQueryID is the default query ID for one page of a profile
+	// section, used by Config.FetchAllSections.
+	DefaultProfileComponentsQueryID = "voyagerIdentityDashProfileComponents.3efef764c5ae6a4ce8b4ce6e1ec2b3f6"
+
+	// DefaultProfileUpdatesQueryID is the default query ID for a member's recent posts.
+	// Like the other query IDs it rotates with LinkedIn web deployments and was taken
+	// from an observed voyagerFeedDashProfileUpdates request.
+	DefaultProfileUpdatesQueryID = "voyagerFeedDashProfileUpdates.4af00b28d60ed0f1488018948daad822"
+
+	// DefaultProfileActivityQueryID is the default query ID for a member's all-activity
+	// feed, which adds the updates they reacted to, commented on or reposted. Unverified:
+	// unlike DefaultProfileUpdatesQueryID it has not been captured from live traffic yet;
+	// set Config.ProfileActivityQueryIDs to a captured ID if LinkedIn rejects it.
+	DefaultProfileActivityQueryID = "voyagerFeedDashProfileUpdates.9c1a5e3f27d84b06a1e2c7d5b8f3e640"
+
+	// SalesNavLeadSearchURL is the Sales Navigator lead search endpoint. Unlike the Voyager
+	// endpoints it is a Rest.li finder rather than GraphQL and requires a Sales Navigator seat.
+	SalesNavLeadSearchURL = "https://www.linkedin.com/sales-api/salesApiLeadSearch"
+
+	// DefaultSalesNavDecorationID selects the lead search projection, playing the role the
+	// query ID plays for GraphQL. Taken from an observed Sales Navigator request.
+	DefaultSalesNavDecorationID = "com.linkedin.sales.deco.desktop.searchv2.LeadSearchResult-14"
+
+	// DefaultSearchIntent is the flagshipSearchIntent used by the web client's people search.
+	DefaultSearchIntent = "SEARCH_SRP"
+
+	// DefaultSearchOrigin is the origin the web client's people search reports once facets
+	// are applied.
+	DefaultSearchOrigin = "FACETED_SEARCH"
+
 	AcceptHeaderValue            = "application/vnd.linkedin.normalized+json+2.1"
 	AcceptEncodingHeaderValue    = "gzip, deflate, br, zstd"
 	AcceptLanguageHeaderValue    = "en-GB,en-US;q=0.9,en;q=0.8"
 	DefaultLiLangHeaderValue     = "en_US"
 	DefaultRestliProtocolVersion = "2.0.0"
-	// DefaultUserAgent is the default user agent for Voyager API calls
-	DefaultUserAgent = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/123.0.0.0 Safari/537.36"
+	// DefaultUserAgent is the default user agent for Voyager API calls, as in the cURL
+	DefaultUserAgent = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/136.0.0.0 Safari/537.36"
+)
+
+const (
+	// DefaultRetryBackoff is the initial delay between retries when Config.RetryBackoff is unset.
+	DefaultRetryBackoff = time.Second
+	// MaxRetryBackoff caps the delay between retries however many attempts were made.
+	MaxRetryBackoff = time.Minute
+
+	// RequestCompressionThreshold is the body size in bytes above which
+	// Config.CompressRequests gzips request bodies.
+	RequestCompressionThreshold = 1024
+
+	// MaxProfileBatchSize is the largest number of member identities LinkedIn accepts in
+	// one batched profile request; BatchGetProfilesByURN chunks larger inputs.
+	MaxProfileBatchSize = 25
+
+	// DefaultGetProfilesConcurrency is the number of parallel fetches GetProfiles uses
+	// when GetProfilesOptions.Concurrency is unset.
+	DefaultGetProfilesConcurrency = 4
+
+	// DefaultFetchPicturesConcurrency is the number of parallel downloads
+	// FetchProfilePictures uses when its concurrency argument is not positive.
+	DefaultFetchPicturesConcurrency = 8
+
+	// MaxProfilePictureBytes bounds the size of a single picture FetchProfilePictures
+	// downloads; larger bodies are dropped rather than buffered.
+	MaxProfilePictureBytes = 5 << 20
+
+	// DefaultMaxSearchPages caps the pages SearchAllProfiles and SearchNewProfiles request
+	// when Config.MaxSearchPages is unset. LinkedIn serves at most 1000 results per search.
+	DefaultMaxSearchPages = 100
+
+	// ProfileSectionPageSize is the number of entries requested per follow-up page when
+	// Config.FetchAllSections pages in a profile section.
+	ProfileSectionPageSize = 20
+)
+
+// LinkedIn "$type" values of the entities in a response's "included" array, for matching
+// GenericIncludedElement.Type when working with raw responses. Most are exact type strings;
+// EntityTypeEndorsedSkill, EntityTypeConnection and EntityTypeFollowing are substrings,
+// matched with strings.Contains, because the full type varies between response versions.
+const (
+	EntityTypeProfile       = "com.linkedin.voyager.dash.identity.profile.Profile"
+	EntityTypePosition      = "com.linkedin.voyager.dash.identity.profile.Position"
+	EntityTypeEducation     = "com.linkedin.voyager.dash.identity.profile.Education"
+	EntityTypeEndorsedSkill = "EndorsedSkill"
+	EntityTypeConnection    = "Connection"
+	EntityTypeFollowing     = "Following"
+	EntityTypeCertification = "com.linkedin.voyager.dash.identity.profile.Certification"
+	EntityTypeGeo           = "com.linkedin.voyager.dash.common.Geo"
+	EntityTypeIndustry      = "com.linkedin.voyager.dash.common.Industry"
+	EntityTypePatent        = "com.linkedin.voyager.dash.identity.profile.Patent"
+	EntityTypePublication   = "com.linkedin.voyager.dash.identity.profile.Publication"
+	EntityTypeInterest      = "com.linkedin.voyager.dash.identity.profile.Interest"
+
+	// EntityTypeTopSkills holds the few skills the member pinned to the profile top card,
+	// separate from the EndorsedSkill entities of the full skills section.
+	EntityTypeTopSkills = "com.linkedin.voyager.dash.identity.profile.ProfileTopSkills"
+
+	// EntityTypeMiniProfile is the compact profile shape embedded in feed and notification
+	// responses; ParseMiniProfile reads it.
+	EntityTypeMiniProfile = "com.linkedin.voyager.identity.shared.MiniProfile"
+
+	// EntityTypeEntityResultViewModel is a single people search result.
+	EntityTypeEntityResultViewModel = "com.linkedin.voyager.dash.search.EntityResultViewModel"
+
+	EntityTypeUpdate               = "com.linkedin.voyager.dash.feed.Update"
+	EntityTypeSocialActivityCounts = "com.linkedin.voyager.dash.feed.SocialActivityCounts"
+	EntityTypeComment              = "com.linkedin.voyager.dash.social.Comment"
+
+	EntityTypeMemberRelationship = "com.linkedin.voyager.dash.relationships.MemberRelationship"
+
+	EntityTypeCompany = "com.linkedin.voyager.dash.organization.Company"
+
+	// EntityTypeCompanyInsights carries a company's Premium insights. Without Premium the
+	// response holds an EntityTypePremiumUpsell instead.
+	EntityTypeCompanyInsights = "com.linkedin.voyager.dash.premium.companyinsights.CompanyInsights"
+	EntityTypePremiumUpsell   = "com.linkedin.voyager.dash.premium.PremiumUpsellSlotContent"
+
+	// EntityTypeServiceProvider lists the services a freelancer offers.
+	EntityTypeServiceProvider = "com.linkedin.voyager.dash.marketplaces.ServiceProvider"
+)
+
+// Values of LinkedInProfile.OpenTo.
+const (
+	OpenToWork         = "work"
+	OpenToHiring       = "hiring"
+	OpenToServices     = "services"
+	OpenToMentoring    = "mentoring"
+	OpenToInvesting    = "investing"
+	OpenToVolunteering = "volunteering"
 )
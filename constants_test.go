@@ -0,0 +1,44 @@
+package linkedinscraper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("entity type constants", func() {
+	DescribeTable("match the $type strings in LinkedIn responses",
+		func(constant, expected string) {
+			Expect(constant).To(Equal(expected))
+		},
+		Entry("Profile", linkedinscraper.EntityTypeProfile, "com.linkedin.voyager.dash.identity.profile.Profile"),
+		Entry("Position", linkedinscraper.EntityTypePosition, "com.linkedin.voyager.dash.identity.profile.Position"),
+		Entry("Education", linkedinscraper.EntityTypeEducation, "com.linkedin.voyager.dash.identity.profile.Education"),
+		Entry("Certification", linkedinscraper.EntityTypeCertification, "com.linkedin.voyager.dash.identity.profile.Certification"),
+		Entry("Patent", linkedinscraper.EntityTypePatent, "com.linkedin.voyager.dash.identity.profile.Patent"),
+		Entry("Publication", linkedinscraper.EntityTypePublication, "com.linkedin.voyager.dash.identity.profile.Publication"),
+		Entry("Interest", linkedinscraper.EntityTypeInterest, "com.linkedin.voyager.dash.identity.profile.Interest"),
+		Entry("Geo", linkedinscraper.EntityTypeGeo, "com.linkedin.voyager.dash.common.Geo"),
+		Entry("EntityResultViewModel", linkedinscraper.EntityTypeEntityResultViewModel, "com.linkedin.voyager.dash.search.EntityResultViewModel"),
+		Entry("MemberRelationship", linkedinscraper.EntityTypeMemberRelationship, "com.linkedin.voyager.dash.relationships.MemberRelationship"),
+		Entry("ServiceProvider", linkedinscraper.EntityTypeServiceProvider, "com.linkedin.voyager.dash.marketplaces.ServiceProvider"),
+		Entry("Update", linkedinscraper.EntityTypeUpdate, "com.linkedin.voyager.dash.feed.Update"),
+		Entry("SocialActivityCounts", linkedinscraper.EntityTypeSocialActivityCounts, "com.linkedin.voyager.dash.feed.SocialActivityCounts"),
+	)
+
+	It("parses a response whose entities are typed with the constants", func() {
+		included := []map[string]interface{}{
+			profileEntity("jane-doe", "Jane", "Doe"),
+			{"$type": linkedinscraper.EntityTypePosition, "entityUrn": "urn:li:fsd_profilePosition:(ACoAAAjane-doe,1)", "companyName": "Acme"},
+			{"$type": linkedinscraper.EntityTypeEducation, "entityUrn": "urn:li:fsd_profileEducation:(ACoAAAjane-doe,1)", "schoolName": "HEC Paris"},
+			{"$type": "com.linkedin.voyager.dash.identity.profile." + linkedinscraper.EntityTypeEndorsedSkill, "entityUrn": "urn:li:fsd_skill:(ACoAAAjane-doe,1)", "name": "Go"},
+		}
+
+		profile, err := linkedinscraper.ParseFromJSON(profileResponseJSON(included...))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.Experience).To(HaveLen(1))
+		Expect(profile.Education).To(HaveLen(1))
+		Expect(profile.Skills).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,41 @@
+package linkedinscraper
+
+// WithCredentialRotation adds accounts to rotate through after Config.Auth, which stays
+// the first active credential. Call RotateCredentials to switch, e.g. after ErrRateLimited.
+// Accounts without an li_at cookie or CSRF token are ignored.
+func WithCredentialRotation(accounts ...AuthCredentials) ClientOption {
+	return func(c *Client) {
+		pool := []AuthCredentials{c.config.Auth}
+		for _, account := range accounts {
+			if account.LiAtCookie != "" && account.CSRFToken != "" {
+				pool = append(pool, account)
+			}
+		}
+		c.credentials = pool
+		c.activeCredential = 0
+	}
+}
+
+// RotateCredentials makes the next account of the rotation pool active and returns it,
+// wrapping around after the last. Requests already in flight, including their retries,
+// keep the credentials they started with. Without WithCredentialRotation it returns
+// Config.Auth.
+func (c *Client) RotateCredentials() AuthCredentials {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.credentials) == 0 {
+		return c.config.Auth
+	}
+	c.activeCredential = (c.activeCredential + 1) % len(c.credentials)
+	return c.credentials[c.activeCredential]
+}
+
+// activeAuth returns the credentials new requests should use.
+func (c *Client) activeAuth() AuthCredentials {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.credentials) == 0 {
+		return c.config.Auth
+	}
+	return c.credentials[c.activeCredential]
+}
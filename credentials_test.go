@@ -0,0 +1,124 @@
+package linkedinscraper_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("Credential rotation", func() {
+	account := func(n int) linkedinscraper.AuthCredentials {
+		token := fmt.Sprintf("ajax:csrf-%d", n)
+		return linkedinscraper.AuthCredentials{LiAtCookie: fmt.Sprintf("li-at-%d", n), CSRFToken: token, JSESSIONID: token}
+	}
+
+	newRotatingClient := func(transport http.RoundTripper, opts ...linkedinscraper.ClientOption) *linkedinscraper.Client {
+		cfg, err := linkedinscraper.NewConfig(account(0))
+		Expect(err).NotTo(HaveOccurred())
+		cfg.MaxRetries = 1
+		cfg.RetryBackoff = time.Millisecond
+		opts = append([]linkedinscraper.ClientOption{
+			linkedinscraper.WithHTTPClient(&http.Client{Transport: transport}),
+			linkedinscraper.WithCredentialRotation(account(1), account(2)),
+		}, opts...)
+		client, err := linkedinscraper.NewClient(cfg, opts...)
+		Expect(err).NotTo(HaveOccurred())
+		return client
+	}
+
+	// liAtOf extracts the li_at value from a request's Cookie header.
+	liAtOf := func(req *http.Request) string {
+		value, _, _ := strings.Cut(strings.TrimPrefix(req.Header.Get("Cookie"), "li_at="), ";")
+		return value
+	}
+
+	It("cycles through the pool and wraps around", func() {
+		transport := newFakeTransport(http.StatusOK, []byte(`{}`))
+		client := newRotatingClient(transport)
+
+		Expect(client.RotateCredentials()).To(Equal(account(1)))
+		Expect(client.RotateCredentials()).To(Equal(account(2)))
+		Expect(client.RotateCredentials()).To(Equal(account(0)))
+
+		client.RotateCredentials()
+		_, _, err := linkedinscraper.MakeRequest(client, context.Background(), http.MethodGet, linkedinscraper.VoyagerBaseURL, http.Header{}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		req := transport.Requests()[0]
+		Expect(liAtOf(req)).To(Equal("li-at-1"))
+		Expect(req.Header.Get("Csrf-Token")).To(Equal("ajax:csrf-1"))
+	})
+
+	It("keeps each request, retries included, on the credentials it started with", func() {
+		var (
+			mu       sync.Mutex
+			seen     = map[string]map[string]bool{} // Request ID -> li_at values used
+			attempts = map[string]int{}
+		)
+		var client *linkedinscraper.Client
+		transport := &fakeTransport{handler: func(req *http.Request) *http.Response {
+			liAt := liAtOf(req)
+			Expect(req.Header.Get("Csrf-Token")).To(Equal("ajax:csrf-" + strings.TrimPrefix(liAt, "li-at-")))
+
+			id := req.Header.Get("X-Request-ID")
+			mu.Lock()
+			defer mu.Unlock()
+			if seen[id] == nil {
+				seen[id] = map[string]bool{}
+			}
+			seen[id][liAt] = true
+			attempts[id]++
+			if attempts[id] == 1 {
+				client.RotateCredentials() // Rotate between this request's attempts
+				return newResponse(http.StatusServiceUnavailable, nil)
+			}
+			return newResponse(http.StatusOK, []byte(`{}`))
+		}}
+		var nextID atomic.Int64
+		client = newRotatingClient(transport, linkedinscraper.WithRequestIDGenerator(func() string {
+			return fmt.Sprint(nextID.Add(1))
+		}))
+
+		stop := make(chan struct{})
+		rotated := make(chan struct{})
+		go func() {
+			defer close(rotated)
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					client.RotateCredentials()
+				}
+			}
+		}()
+
+		var wg sync.WaitGroup
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				_, _, err := linkedinscraper.MakeRequest(client, context.Background(), http.MethodGet, linkedinscraper.VoyagerBaseURL, http.Header{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}()
+		}
+		wg.Wait()
+		close(stop)
+		<-rotated
+
+		Expect(seen).To(HaveLen(50))
+		for id, liAts := range seen {
+			Expect(liAts).To(HaveLen(1), "request %s switched credentials between attempts", id)
+			Expect(attempts[id]).To(Equal(2))
+		}
+	})
+})
@@ -0,0 +1,159 @@
+package linkedinscraper
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// decodeResponse unmarshals a response body into v. With Config.StrictDecoding it also
+// checks the body for fields v's type does not model and logs each newly seen one, an
+// early warning that LinkedIn renamed or added fields the parsers would silently drop.
+func (c *Client) decodeResponse(data []byte, v any) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	if c.config.StrictDecoding {
+		c.reportUnknownFields(data, reflect.TypeOf(v))
+	}
+	return nil
+}
+
+// reportUnknownFields logs the fields of data unknown to t that this client has not
+// reported before.
+func (c *Client) reportUnknownFields(data []byte, t reflect.Type) {
+	// A strict decode into a scratch value is the cheap check; only drifted responses are walked
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(reflect.New(t.Elem()).Interface()); err == nil || !strings.Contains(err.Error(), "unknown field") {
+		return
+	}
+
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return
+	}
+	for _, field := range unknownFields(raw, t) {
+		c.mu.Lock()
+		seen := c.unknownFields[field]
+		if c.unknownFields == nil {
+			c.unknownFields = make(map[string]bool)
+		}
+		c.unknownFields[field] = true
+		c.mu.Unlock()
+
+		if !seen {
+			c.logWarn("unknown response field", "type", t.Elem().Name(), "field", field)
+		}
+	}
+}
+
+var jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+
+// unknownFields returns the sorted paths of the object keys in raw, a value decoded into
+// an any, that t has no field for, e.g. "included[].newField". Map keys appear as "*".
+// Unmodeled "$"-prefixed metadata keys are not reported, and types with their own
+// UnmarshalJSON are trusted to handle whatever they receive.
+func unknownFields(raw any, t reflect.Type) []string {
+	found := make(map[string]bool)
+	collectUnknownFields(raw, t, "", found)
+
+	fields := make([]string, 0, len(found))
+	for field := range found {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func collectUnknownFields(raw any, t reflect.Type, path string, found map[string]bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(jsonUnmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := raw.(map[string]any)
+		if !ok {
+			return
+		}
+		fields := jsonFieldTypes(t)
+		for key, value := range object {
+			fieldPath := joinFieldPath(path, key)
+			fieldType, ok := fields[strings.ToLower(key)]
+			if !ok && strings.HasPrefix(key, "$") {
+				continue // Rest.li metadata such as "$type" and "$recipeTypes" carries no data
+			}
+			if !ok {
+				found[fieldPath] = true
+				continue
+			}
+			collectUnknownFields(value, fieldType, fieldPath, found)
+		}
+	case reflect.Map:
+		object, ok := raw.(map[string]any)
+		if !ok {
+			return
+		}
+		for _, value := range object {
+			collectUnknownFields(value, t.Elem(), joinFieldPath(path, "*"), found)
+		}
+	case reflect.Slice, reflect.Array:
+		elements, ok := raw.([]any)
+		if !ok {
+			return
+		}
+		for _, value := range elements {
+			collectUnknownFields(value, t.Elem(), path+"[]", found)
+		}
+	}
+}
+
+// jsonFieldTypes maps the lowercased JSON names of t's fields to their types, flattening
+// embedded structs the way encoding/json does. Lowercasing mirrors encoding/json's
+// case-insensitive key matching.
+func jsonFieldTypes(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for embeddedName, embeddedType := range jsonFieldTypes(embedded) {
+					if _, ok := fields[embeddedName]; !ok {
+						fields[embeddedName] = embeddedType
+					}
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[strings.ToLower(name)] = field.Type
+	}
+	return fields
+}
+
+// joinFieldPath appends key to a dotted field path.
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,56 @@
+package linkedinscraper
+
+import (
+	"context"
+	"sync"
+)
+
+var (
+	defaultClientMu      sync.RWMutex
+	defaultClientOptions []ClientOption
+)
+
+// SetDefaultClientOptions sets the options applied to the clients built by the
+// package-level SearchProfiles and GetProfile, e.g. WithHTTPClient to route them through
+// a proxy or a test transport. It replaces any previously set options.
+func SetDefaultClientOptions(opts ...ClientOption) {
+	defaultClientMu.Lock()
+	defer defaultClientMu.Unlock()
+	defaultClientOptions = append([]ClientOption(nil), opts...)
+}
+
+// newDefaultClient builds a client with the default configuration for auth.
+func newDefaultClient(auth AuthCredentials) (*Client, error) {
+	cfg, err := NewConfig(auth)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultClientMu.RLock()
+	opts := defaultClientOptions
+	defaultClientMu.RUnlock()
+
+	return NewClient(cfg, opts...)
+}
+
+// SearchProfiles runs a single people search with a default client for auth. It is meant
+// for scripts and one-off calls; every call builds a new client, so anything issuing more
+// than a handful of requests should create a Client once and reuse it, keeping its rate
+// limit tracking, throttling and connection pool.
+func SearchProfiles(ctx context.Context, auth AuthCredentials, args ProfileSearchArgs) ([]LinkedInProfile, error) {
+	client, err := newDefaultClient(auth)
+	if err != nil {
+		return nil, err
+	}
+	return client.SearchProfiles(ctx, args)
+}
+
+// GetProfile fetches a single profile with a default client for auth. Like SearchProfiles
+// it builds a new client per call; reuse a Client for anything beyond one-off lookups.
+func GetProfile(ctx context.Context, auth AuthCredentials, publicIdentifier string) (*LinkedInProfile, error) {
+	client, err := newDefaultClient(auth)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetProfile(ctx, publicIdentifier)
+}
@@ -0,0 +1,79 @@
+package linkedinscraper_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("package-level calls", func() {
+	var (
+		ctx       context.Context
+		auth      linkedinscraper.AuthCredentials
+		transport *fakeTransport
+		clock     linkedinscraper.ClientOption
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		auth = linkedinscraper.AuthCredentials{LiAtCookie: "test-li-at", CSRFToken: "ajax:test-csrf"}
+		transport = &fakeTransport{handler: func(req *http.Request) *http.Response {
+			if strings.Contains(req.URL.RawQuery, "vanityName") {
+				return newResponse(http.StatusOK, loadFixture("profile.json"))
+			}
+			return newResponse(http.StatusOK, searchResponseJSON(
+				entityResult("urn:li:member:1", "Jane Doe", "Investor", "Paris", "https://www.linkedin.com/in/jane-doe"),
+			))
+		}}
+		fixed := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		clock = linkedinscraper.WithClock(func() time.Time { return fixed })
+
+		linkedinscraper.SetDefaultClientOptions(linkedinscraper.WithHTTPClient(&http.Client{Transport: transport}), clock)
+		DeferCleanup(linkedinscraper.SetDefaultClientOptions)
+	})
+
+	explicitClient := func() *linkedinscraper.Client {
+		cfg, err := linkedinscraper.NewConfig(auth)
+		Expect(err).NotTo(HaveOccurred())
+		client, err := linkedinscraper.NewClient(cfg, linkedinscraper.WithHTTPClient(&http.Client{Transport: transport}), clock)
+		Expect(err).NotTo(HaveOccurred())
+		return client
+	}
+
+	It("searches like an explicit client", func() {
+		args := linkedinscraper.ProfileSearchArgs{Keywords: "investor", NetworkFilters: []linkedinscraper.NetworkFilter{linkedinscraper.NetworkSecondDegree}}
+
+		profiles, err := linkedinscraper.SearchProfiles(ctx, auth, args)
+		Expect(err).NotTo(HaveOccurred())
+		expected, err := explicitClient().SearchProfiles(ctx, args)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profiles).To(Equal(expected))
+		requests := transport.Requests()
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[0].URL.String()).To(Equal(requests[1].URL.String()))
+		Expect(requests[0].Header.Get("Csrf-Token")).To(Equal("ajax:test-csrf"))
+	})
+
+	It("fetches profiles like an explicit client", func() {
+		profile, err := linkedinscraper.GetProfile(ctx, auth, "jane-doe")
+		Expect(err).NotTo(HaveOccurred())
+		expected, err := explicitClient().GetProfile(ctx, "jane-doe")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profile).To(Equal(expected))
+		Expect(profile.FullName).NotTo(BeEmpty())
+	})
+
+	It("rejects missing credentials before sending anything", func() {
+		_, err := linkedinscraper.GetProfile(ctx, linkedinscraper.AuthCredentials{}, "jane-doe")
+		Expect(err).To(MatchError(linkedinscraper.ErrAuthMissing))
+		Expect(transport.Requests()).To(BeEmpty())
+	})
+})
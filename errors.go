@@ -5,11 +5,26 @@ import "errors"
 var ErrAuthMissing = errors.New("linkedinscraper: authentication credentials (li_at, csrf_token) are missing")
 
 var (
-	ErrKeywordsMissing     = errors.New("linkedinscraper: search keywords are missing")
-	ErrRequestBuildFailed  = errors.New("linkedinscraper: failed to build API request")
-	ErrRequestFailed       = errors.New("linkedinscraper: API request failed") // Generic for HTTP issues
-	ErrUnauthorized        = errors.New("linkedinscraper: unauthorized, check credentials or IP reputation")
-	ErrRateLimited         = errors.New("linkedinscraper: rate limited by API")
-	ErrResponseParseFailed = errors.New("linkedinscraper: failed to parse API response")
-	ErrNoProfilesFound     = errors.New("linkedinscraper: no profiles found matching criteria") // Or handle this by returning empty slice
+	ErrKeywordsMissing      = errors.New("linkedinscraper: search keywords are missing")
+	ErrInvalidSortBy        = errors.New("linkedinscraper: unknown search sort order")
+	ErrRequestBuildFailed   = errors.New("linkedinscraper: failed to build API request")
+	ErrRequestFailed        = errors.New("linkedinscraper: API request failed") // Generic for HTTP issues
+	ErrUnauthorized         = errors.New("linkedinscraper: unauthorized, check credentials or IP reputation")
+	ErrRateLimited          = errors.New("linkedinscraper: rate limited by API")
+	ErrResponseParseFailed  = errors.New("linkedinscraper: failed to parse API response")
+	ErrNoProfilesFound      = errors.New("linkedinscraper: no profiles found matching criteria") // Or handle this by returning empty slice
+	ErrQueryIDDeprecated    = errors.New("linkedinscraper: GraphQL query ID was rejected as deprecated, update the configured query IDs")
+	ErrSessionExpiryUnknown = errors.New("linkedinscraper: session expiry could not be determined from the response")
+	ErrProfileRestricted    = errors.New("linkedinscraper: profile exists but is not viewable (out of network or restricted)")
+	ErrUnknownEntityType    = errors.New("linkedinscraper: response contains unrecognised entity types")
+	ErrAuthChallenge        = errors.New("linkedinscraper: LinkedIn answered with a login or security challenge, complete it in a browser")
+	ErrEmptyResponse        = errors.New("linkedinscraper: response contains no entities, the query ID may be broken")
+	ErrNotFound             = errors.New("linkedinscraper: not found, e.g. a deleted profile")
+	ErrInvalidURN           = errors.New("linkedinscraper: malformed URN or unexpected URN namespace")
+	ErrInvalidSearchFacet   = errors.New("linkedinscraper: geo-filtered search returned nothing, a geo URN may be invalid")
+	ErrInvalidSearchURL     = errors.New("linkedinscraper: not a LinkedIn people search URL")
+	ErrPictureURLExpired    = errors.New("linkedinscraper: signed picture URL has expired, fetch the profile again for a fresh one")
+	ErrIncompleteResponse   = errors.New("linkedinscraper: response body could not be read in full, e.g. corrupt compression or a dropped connection")
+	ErrPremiumRequired      = errors.New("linkedinscraper: data is only available to LinkedIn Premium subscribers")
+	ErrNoSessionCookie      = errors.New("linkedinscraper: response set no JSESSIONID cookie, the li_at cookie may be invalid")
 )
@@ -0,0 +1,57 @@
+package linkedinscraper
+
+import (
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+// Internal hooks exposed to the external linkedinscraper_test package.
+
+// MakeRequest exposes makeRequest so specs can exercise request plumbing directly.
+var MakeRequest = (*Client).makeRequest
+
+// HTTPTransport returns the transport of the client's HTTP client, nil for the default.
+func HTTPTransport(c *Client) http.RoundTripper {
+	return c.httpClient.Transport
+}
+
+// RetryDelay exposes the backoff before retry attempt+1.
+var RetryDelay = (*Client).retryDelay
+
+// CleanDescription exposes the description cleanup applied with Config.CleanDescriptions.
+var CleanDescription = cleanDescription
+
+// ParseProfileResponse exposes the profile parser with default options for benchmarks.
+func ParseProfileResponse(apiResponse *ProfileAPIResponse, publicIdentifier string) (*LinkedInProfile, error) {
+	return parseProfileFromAPIResponse(apiResponse, publicIdentifier, parseOptions{})
+}
+
+// TrackDecoderCloses wraps every response decoder to count the decompressors opened and
+// closed. Call restore once done.
+func TrackDecoderCloses() (opened, closed *atomic.Int32, restore func()) {
+	opened, closed = new(atomic.Int32), new(atomic.Int32)
+	original := responseDecoders
+	responseDecoders = make(map[string]func(io.Reader) (io.ReadCloser, error), len(original))
+	for encoding, newDecoder := range original {
+		responseDecoders[encoding] = func(r io.Reader) (io.ReadCloser, error) {
+			decoder, err := newDecoder(r)
+			if err != nil {
+				return nil, err
+			}
+			opened.Add(1)
+			return &trackedDecoder{ReadCloser: decoder, closed: closed}, nil
+		}
+	}
+	return opened, closed, func() { responseDecoders = original }
+}
+
+type trackedDecoder struct {
+	io.ReadCloser
+	closed *atomic.Int32
+}
+
+func (d *trackedDecoder) Close() error {
+	d.closed.Add(1)
+	return d.ReadCloser.Close()
+}
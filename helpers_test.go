@@ -0,0 +1,164 @@
+package linkedinscraper_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+// fakeTransport is an http.RoundTripper that serves canned responses and records
+// every request it receives, so specs can run without touching LinkedIn.
+type fakeTransport struct {
+	mu       sync.Mutex
+	requests []*http.Request
+	bodies   [][]byte
+	handler  func(req *http.Request) *http.Response
+}
+
+func (f *fakeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		body, _ = io.ReadAll(req.Body)
+		req.Body.Close()
+	}
+
+	f.mu.Lock()
+	f.requests = append(f.requests, req)
+	f.bodies = append(f.bodies, body)
+	f.mu.Unlock()
+
+	resp := f.handler(req)
+	if resp.Request == nil {
+		resp.Request = req
+	}
+	return resp, nil
+}
+
+// Requests returns a snapshot of the requests seen so far.
+func (f *fakeTransport) Requests() []*http.Request {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*http.Request(nil), f.requests...)
+}
+
+// Bodies returns a snapshot of the request bodies seen so far.
+func (f *fakeTransport) Bodies() [][]byte {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([][]byte(nil), f.bodies...)
+}
+
+// newFakeTransport returns a transport answering every request with status and body.
+func newFakeTransport(status int, body []byte) *fakeTransport {
+	return &fakeTransport{handler: func(*http.Request) *http.Response {
+		return newResponse(status, body)
+	}}
+}
+
+// newResponse builds a minimal *http.Response for a fake transport.
+func newResponse(status int, body []byte) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}
+}
+
+// newTestClient returns a client with dummy credentials that talks to transport.
+func newTestClient(transport http.RoundTripper, configure ...func(*linkedinscraper.Config)) *linkedinscraper.Client {
+	cfg, err := linkedinscraper.NewConfig(linkedinscraper.AuthCredentials{
+		LiAtCookie: "test-li-at",
+		CSRFToken:  "ajax:test-csrf",
+		JSESSIONID: "ajax:test-csrf",
+	})
+	Expect(err).NotTo(HaveOccurred())
+	for _, fn := range configure {
+		fn(cfg)
+	}
+
+	client, err := linkedinscraper.NewClient(cfg, linkedinscraper.WithHTTPClient(&http.Client{Transport: transport}))
+	Expect(err).NotTo(HaveOccurred())
+	return client
+}
+
+// loadFixture reads a saved API response from testdata.
+func loadFixture(name string) []byte {
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	Expect(err).NotTo(HaveOccurred())
+	return data
+}
+
+// searchResponseJSON wraps the given included entities in a search API response envelope.
+func searchResponseJSON(included ...map[string]interface{}) []byte {
+	return pagedSearchResponseJSON(0, 10, len(included), included...)
+}
+
+// pagedSearchResponseJSON is like searchResponseJSON but reports the given page and total.
+func pagedSearchResponseJSON(start, count, total int, included ...map[string]interface{}) []byte {
+	if included == nil {
+		included = []map[string]interface{}{}
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"data": map[string]interface{}{
+			"data": map[string]interface{}{
+				"searchDashClustersByAll": map[string]interface{}{
+					"metadata": map[string]interface{}{"totalResultCount": total},
+					"paging":   map[string]interface{}{"start": start, "count": count, "total": total},
+					"elements": []interface{}{},
+				},
+			},
+		},
+		"included": included,
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return data
+}
+
+// entityResult builds an EntityResultViewModel entity as found in search responses.
+func entityResult(trackingURN, name, headline, location, navigationURL string) map[string]interface{} {
+	return map[string]interface{}{
+		"$type":             "com.linkedin.voyager.dash.search.EntityResultViewModel",
+		"entityUrn":         "urn:li:fsd_entityResultViewModel:(" + trackingURN + ",SEARCH_SRP,DEFAULT)",
+		"trackingUrn":       trackingURN,
+		"title":             map[string]interface{}{"text": name},
+		"primarySubtitle":   map[string]interface{}{"text": headline},
+		"secondarySubtitle": map[string]interface{}{"text": location},
+		"navigationUrl":     navigationURL,
+	}
+}
+
+// profileResponseJSON wraps the given included entities in a profile API response envelope.
+func profileResponseJSON(included ...map[string]interface{}) []byte {
+	if included == nil {
+		included = []map[string]interface{}{}
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"data": map[string]interface{}{
+			"data": map[string]interface{}{
+				"identityDashProfilesByMemberIdentity": map[string]interface{}{"*elements": []string{}},
+			},
+		},
+		"included": included,
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return data
+}
+
+// profileEntity builds a minimal Profile entity as found in profile responses.
+func profileEntity(publicIdentifier, firstName, lastName string) map[string]interface{} {
+	return map[string]interface{}{
+		"$type":            "com.linkedin.voyager.dash.identity.profile.Profile",
+		"entityUrn":        "urn:li:fsd_profile:ACoAAA" + publicIdentifier,
+		"publicIdentifier": publicIdentifier,
+		"firstName":        firstName,
+		"lastName":         lastName,
+	}
+}
@@ -0,0 +1,69 @@
+package linkedinscraper
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// NormalizePublicIdentifier reduces a public identifier or profile URL to its canonical,
+// lowercase form, e.g. "https://www.linkedin.com/in/Jane-Doe/?trk=feed" and "jane-doe/"
+// both become "jane-doe". It returns "" when no identifier can be found.
+func NormalizePublicIdentifier(identifier string) string {
+	identifier = strings.TrimSpace(identifier)
+	if i := strings.IndexAny(identifier, "?#"); i >= 0 {
+		identifier = identifier[:i]
+	}
+	if i := strings.LastIndex(identifier, "/in/"); i >= 0 {
+		identifier = identifier[i+len("/in/"):]
+	}
+	identifier = strings.Trim(identifier, "/")
+	if i := strings.Index(identifier, "/"); i >= 0 {
+		identifier = identifier[:i]
+	}
+	if unescaped, err := url.PathUnescape(identifier); err == nil {
+		identifier = unescaped
+	}
+	return strings.ToLower(identifier)
+}
+
+// SamePublicIdentifier reports whether a and b, each a public identifier or profile URL,
+// refer to the same profile once normalized with NormalizePublicIdentifier.
+func SamePublicIdentifier(a, b string) bool {
+	normalized := NormalizePublicIdentifier(a)
+	return normalized != "" && normalized == NormalizePublicIdentifier(b)
+}
+
+// MemberIDFromURN returns the member identity token at the end of a profile URN, e.g.
+// "ACoAAAtp-4UB" for "urn:li:fsd_profile:ACoAAAtp-4UB". The fsd_profile, fs_profile and
+// fs_miniProfile namespaces share this token; for "urn:li:member:123" the numeric ID is
+// returned. Anything else, including compound URNs, yields ErrInvalidURN.
+func MemberIDFromURN(urn string) (string, error) {
+	namespaces := append([]string{memberURNPrefix}, profileURNNamespaces...)
+	for _, namespace := range namespaces {
+		id, ok := strings.CutPrefix(urn, namespace)
+		if !ok {
+			continue
+		}
+		if id == "" || strings.IndexFunc(id, func(r rune) bool { return !isMemberIDRune(r, namespace == memberURNPrefix) }) >= 0 {
+			return "", fmt.Errorf("%w: %q", ErrInvalidURN, urn)
+		}
+		return id, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidURN, urn)
+}
+
+// memberURNPrefix is the namespace of numeric member URNs, e.g. "urn:li:member:123".
+const memberURNPrefix = "urn:li:member:"
+
+// isMemberIDRune reports whether r may appear in a member ID: digits for numeric member
+// URNs, and the URL-safe base64 alphabet for profile URNs.
+func isMemberIDRune(r rune, numeric bool) bool {
+	switch {
+	case r >= '0' && r <= '9':
+		return true
+	case numeric:
+		return false
+	}
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '-' || r == '_'
+}
@@ -0,0 +1,56 @@
+package linkedinscraper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("public identifiers", func() {
+	DescribeTable("NormalizePublicIdentifier",
+		func(input, expected string) {
+			Expect(linkedinscraper.NormalizePublicIdentifier(input)).To(Equal(expected))
+		},
+		Entry("bare id", "jane-doe", "jane-doe"),
+		Entry("mixed case with trailing slash", "Jane-Doe/", "jane-doe"),
+		Entry("full URL with query", "https://www.linkedin.com/in/Jane-Doe/?trk=feed#about", "jane-doe"),
+		Entry("URL with a subpage", "https://linkedin.com/in/jane-doe/details/experience/", "jane-doe"),
+		Entry("path only", "/in/jane-doe", "jane-doe"),
+		Entry("percent-encoded", "https://www.linkedin.com/in/jos%C3%A9-garc%C3%ADa", "josé-garcía"),
+		Entry("empty", "  ", ""),
+	)
+
+	DescribeTable("SamePublicIdentifier",
+		func(a, b string, expected bool) {
+			Expect(linkedinscraper.SamePublicIdentifier(a, b)).To(Equal(expected))
+		},
+		Entry("bare id and full URL", "jane-doe", "https://www.linkedin.com/in/Jane-Doe/?miniProfileUrn=abc", true),
+		Entry("case and trailing slash", "JANE-DOE/", "jane-doe", true),
+		Entry("different people", "jane-doe", "https://www.linkedin.com/in/john-roe/", false),
+		Entry("both empty", "", "", false),
+	)
+
+	DescribeTable("MemberIDFromURN",
+		func(urn, expected string) {
+			id, err := linkedinscraper.MemberIDFromURN(urn)
+			if expected == "" {
+				Expect(err).To(MatchError(linkedinscraper.ErrInvalidURN))
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal(expected))
+		},
+		Entry("fsd_profile", "urn:li:fsd_profile:ACoAAAtp-4UBpQ0aZ_PeToflBoLty9BpO_CQ6-I", "ACoAAAtp-4UBpQ0aZ_PeToflBoLty9BpO_CQ6-I"),
+		Entry("fs_miniProfile", "urn:li:fs_miniProfile:ACoAAAJaneDoe", "ACoAAAJaneDoe"),
+		Entry("fs_profile", "urn:li:fs_profile:ACoAAAJaneDoe", "ACoAAAJaneDoe"),
+		Entry("numeric member", "urn:li:member:123456789", "123456789"),
+		Entry("empty", "", ""),
+		Entry("missing token", "urn:li:fsd_profile:", ""),
+		Entry("unknown namespace", "urn:li:company:1035", ""),
+		Entry("compound URN", "urn:li:fsd_profile:(ACoAAAJaneDoe,en_US)", ""),
+		Entry("trailing segment", "urn:li:fsd_profile:ACoAAAJaneDoe:extra", ""),
+		Entry("non-numeric member", "urn:li:member:abc", ""),
+		Entry("bare token", "ACoAAAJaneDoe", ""),
+	)
+})
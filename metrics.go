@@ -0,0 +1,72 @@
+package linkedinscraper
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// ResponseMetrics describes the body of one API response, reported to the hook set with
+// WithResponseMetrics.
+type ResponseMetrics struct {
+	Operation  string // Client operation that made the request, e.g. "GetProfile"
+	URL        string // Sanitized request URL
+	StatusCode int
+	// BytesRead is the size of the body after decompression.
+	BytesRead int64
+	// CompressedBytes is the size of the body on the wire when the client decompressed
+	// it, zero when the body was not compressed or its compressed size is unknown.
+	CompressedBytes int64
+}
+
+// CompressionRatio returns BytesRead divided by CompressedBytes, e.g. 8 for a body that
+// shrank to an eighth when compressed, or 0 when the compressed size is unknown.
+func (m ResponseMetrics) CompressionRatio() float64 {
+	if m.CompressedBytes == 0 {
+		return 0
+	}
+	return float64(m.BytesRead) / float64(m.CompressedBytes)
+}
+
+// WithResponseMetrics calls hook with the size of every response body the client reads,
+// including those of retried attempts, e.g. to track bandwidth costs. The hook runs on
+// the requesting goroutine and must be safe for concurrent use when the client is.
+func WithResponseMetrics(hook func(ResponseMetrics)) ClientOption {
+	return func(c *Client) {
+		c.metricsHook = hook
+	}
+}
+
+// TotalBytesRead returns the decompressed size of all response bodies the client has
+// read so far, including those of retried attempts.
+func (c *Client) TotalBytesRead() int64 {
+	return c.bytesRead.Load()
+}
+
+// recordResponseMetrics adds a response body to the running total and reports it to the
+// metrics hook, if any.
+func (c *Client) recordResponseMetrics(ctx context.Context, urlStr string, resp *http.Response, bytesRead, compressedBytes int64) {
+	c.bytesRead.Add(bytesRead)
+	if c.metricsHook == nil {
+		return
+	}
+	c.metricsHook(ResponseMetrics{
+		Operation:       operationFromContext(ctx),
+		URL:             sanitizeURL(urlStr),
+		StatusCode:      resp.StatusCode,
+		BytesRead:       bytesRead,
+		CompressedBytes: compressedBytes,
+	})
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,99 @@
+package linkedinscraper_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("response metrics", func() {
+	var (
+		ctx     context.Context
+		mu      sync.Mutex
+		metrics []linkedinscraper.ResponseMetrics
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		metrics = nil
+	})
+
+	newMetricsClient := func(transport http.RoundTripper) *linkedinscraper.Client {
+		cfg, err := linkedinscraper.NewConfig(linkedinscraper.AuthCredentials{LiAtCookie: "test-li-at", CSRFToken: "ajax:test-csrf"})
+		Expect(err).NotTo(HaveOccurred())
+		client, err := linkedinscraper.NewClient(cfg,
+			linkedinscraper.WithHTTPClient(&http.Client{Transport: transport}),
+			linkedinscraper.WithResponseMetrics(func(m linkedinscraper.ResponseMetrics) {
+				mu.Lock()
+				defer mu.Unlock()
+				metrics = append(metrics, m)
+			}),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		return client
+	}
+
+	It("counts the bytes of every response body", func() {
+		profile := loadFixture("profile.json")
+		search := loadFixture("search_industry.json")
+		transport := &fakeTransport{handler: func(req *http.Request) *http.Response {
+			if strings.Contains(req.URL.RawQuery, "vanityName") {
+				return newResponse(http.StatusOK, profile)
+			}
+			return newResponse(http.StatusOK, search)
+		}}
+		client := newMetricsClient(transport)
+		Expect(client.TotalBytesRead()).To(BeZero())
+
+		_, err := client.GetProfile(ctx, "jane-doe")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.TotalBytesRead()).To(Equal(int64(len(profile))))
+
+		_, err = client.SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.TotalBytesRead()).To(Equal(int64(len(profile) + len(search))))
+
+		Expect(metrics).To(HaveLen(2))
+		Expect(metrics[0].Operation).To(Equal("GetProfile"))
+		Expect(metrics[0].StatusCode).To(Equal(http.StatusOK))
+		Expect(metrics[0].BytesRead).To(Equal(int64(len(profile))))
+		Expect(metrics[0].CompressedBytes).To(BeZero())
+		Expect(metrics[0].CompressionRatio()).To(BeZero())
+		Expect(metrics[1].Operation).To(Equal("SearchProfiles"))
+		Expect(metrics[1].BytesRead).To(Equal(int64(len(search))))
+	})
+
+	It("reports the compression ratio of gzip bodies", func() {
+		profile := loadFixture("profile.json")
+		var compressed bytes.Buffer
+		zw := gzip.NewWriter(&compressed)
+		_, err := zw.Write(profile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(zw.Close()).To(Succeed())
+
+		transport := &fakeTransport{handler: func(*http.Request) *http.Response {
+			resp := newResponse(http.StatusOK, compressed.Bytes())
+			resp.Header.Set("Content-Encoding", "gzip")
+			return resp
+		}}
+		client := newMetricsClient(transport)
+
+		_, err = client.GetProfile(ctx, "jane-doe")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.TotalBytesRead()).To(Equal(int64(len(profile))))
+
+		Expect(metrics).To(HaveLen(1))
+		Expect(metrics[0].BytesRead).To(Equal(int64(len(profile))))
+		Expect(metrics[0].CompressedBytes).To(Equal(int64(compressed.Len())))
+		Expect(metrics[0].CompressionRatio()).To(BeNumerically("~", float64(len(profile))/float64(compressed.Len())))
+		Expect(metrics[0].CompressionRatio()).To(BeNumerically(">", 1))
+	})
+})
@@ -3,20 +3,100 @@ package linkedinscraper
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// NetworkFilter is a connection-degree code accepted in ProfileSearchArgs.NetworkFilters.
+// It is an alias of string so the named constants and raw codes can be mixed freely.
+type NetworkFilter = string
+
+// Known network filter codes.
+const (
+	NetworkFirstDegree  NetworkFilter = "F" // 1st-degree connections
+	NetworkSecondDegree NetworkFilter = "S" // 2nd-degree connections
+	NetworkThirdPlus    NetworkFilter = "O" // 3rd-degree and everyone else ("Outside of your network")
+)
+
+// AllNetworkFilters returns every known network filter code, closest degree first.
+func AllNetworkFilters() []NetworkFilter {
+	return []NetworkFilter{NetworkFirstDegree, NetworkSecondDegree, NetworkThirdPlus}
+}
+
+// networkDistanceCode maps LinkedIn's connection-degree representations, the API's
+// "DISTANCE_2" or a search badge like "• 2nd", to the matching NetworkFilter code.
+// It returns "" for the viewer's own profile and unrecognised values.
+func networkDistanceCode(raw string) NetworkFilter {
+	switch {
+	case strings.Contains(raw, "DISTANCE_1"), strings.Contains(raw, "1st"):
+		return NetworkFirstDegree
+	case strings.Contains(raw, "DISTANCE_2"), strings.Contains(raw, "2nd"):
+		return NetworkSecondDegree
+	case strings.Contains(raw, "DISTANCE_3"), strings.Contains(raw, "3rd"), strings.Contains(raw, "OUT_OF_NETWORK"):
+		return NetworkThirdPlus
+	}
+	return ""
+}
+
+// Known values for ProfileSearchArgs.SortBy.
+const (
+	SortByRelevance      = "RELEVANCE"       // LinkedIn's default ranking
+	SortByRecentlyJoined = "RECENTLY_JOINED" // Most recently joined members first
+)
+
+// validSortBy reports whether sortBy is empty or one of the known SortBy values.
+func validSortBy(sortBy string) bool {
+	switch sortBy {
+	case "", SortByRelevance, SortByRecentlyJoined:
+		return true
+	}
+	return false
+}
+
 // ProfileSearchArgs represents the arguments for initiating a profile search.
 type ProfileSearchArgs struct {
 	Keywords       string
-	NetworkFilters []string // e.g., ["F", "O"] for 1st degree and Outside network
+	NetworkFilters []NetworkFilter // e.g., ["F", "O"] (NetworkFirstDegree, NetworkThirdPlus) for 1st degree and Outside network
 	Start          int
-	Count          int // Added based on typical pagination and cURL example
+	Count          int      // Added based on typical pagination and cURL example
+	GeoURNs        []string // e.g., ["103644278"] (United States), emitted as the geoUrn facet
+	// GeoRadius limits results to within the given distance in miles of GeoURNs.
+	// Only emitted when GeoURNs is non-empty. Values mirror the web UI's distance
+	// facet: "10", "25", "35", "50", "75" and "100".
+	GeoRadius string
+	// SearchIntent sets the flagshipSearchIntent variable, which affects ranking and the
+	// facets available. Defaults to DefaultSearchIntent ("SEARCH_SRP").
+	SearchIntent string
+	// SortBy orders the results, emitted as the sortBy facet. Must be empty or one of
+	// SortByRelevance and SortByRecentlyJoined; empty leaves LinkedIn's default (relevance).
+	SortBy string
+	// IncludePartialResults keeps results that lack a name, headline or location instead of
+	// skipping them; such profiles carry whatever fields are available, at least a URN.
+	IncludePartialResults bool
+	// ContinuationToken continues a search from the "see all results" cluster of a previous
+	// page, as reported in SearchMetadata.ContinuationToken. It is sent as the
+	// paginationToken variable alongside Start.
+	ContinuationToken string
+	// Origin sets the origin variable and Referer parameter, which name the part of the
+	// web UI the search came from, e.g. "GLOBAL_SEARCH_HEADER". Defaults to
+	// DefaultSearchOrigin ("FACETED_SEARCH").
+	Origin string
 	// Add other potential search parameters here if identified.
-	// Origin string // e.g., "FACETED_SEARCH", also a potential parameter
 	XLiPageInstance string // Optional: To override default placeholder
 	XLiTrack        string // Optional: To override default placeholder
 }
 
+// SearchMetadata describes the page of results a search returned.
+type SearchMetadata struct {
+	TotalResults int `json:"totalResults"` // Total matches LinkedIn reports for the query
+	Start        int `json:"start"`        // Offset of the returned page
+	Count        int `json:"count"`        // Page size LinkedIn applied
+	// ContinuationToken is the token of the page's "see all results" cluster, if any; set it
+	// as ProfileSearchArgs.ContinuationToken to request the next page.
+	ContinuationToken string `json:"continuationToken,omitempty"`
+}
+
 // Date represents a LinkedIn date structure
 type Date struct {
 	Year  int `json:"year,omitempty"`
@@ -52,6 +132,7 @@ type Education struct {
 	DateRange    *DateRange `json:"dateRange,omitempty"`
 	Description  string     `json:"description,omitempty"`
 	Activities   string     `json:"activities,omitempty"`
+	Grade        string     `json:"grade,omitempty"` // e.g. "3.9 GPA" or "First Class Honours"
 }
 
 // Skill represents a skill entry
@@ -62,6 +143,14 @@ type Skill struct {
 	EndorsedByViewer bool   `json:"endorsedByViewer,omitempty"`
 }
 
+// Interests lists what a member follows, as shown in the profile's Interests section.
+type Interests struct {
+	Companies   []string `json:"companies,omitempty"`
+	Influencers []string `json:"influencers,omitempty"`
+	Hashtags    []string `json:"hashtags,omitempty"` // Without the leading "#"
+	Schools     []string `json:"schools,omitempty"`
+}
+
 // Certification represents a certification entry
 type Certification struct {
 	EntityURN     string     `json:"entityUrn,omitempty"`
@@ -72,6 +161,50 @@ type Certification struct {
 	URL           string     `json:"url,omitempty"`
 }
 
+// Patent represents a patent entry
+type Patent struct {
+	EntityURN string `json:"entityUrn,omitempty"`
+	Title     string `json:"title,omitempty"`
+	Number    string `json:"number,omitempty"`
+	Issuer    string `json:"issuer,omitempty"`
+	Date      *Date  `json:"date,omitempty"` // Issue date
+	URL       string `json:"url,omitempty"`
+}
+
+// Publication represents a publication entry
+type Publication struct {
+	EntityURN   string `json:"entityUrn,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Publisher   string `json:"publisher,omitempty"`
+	Date        *Date  `json:"date,omitempty"` // Publication date
+	URL         string `json:"url,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// SectionStats counts a profile section's entities seen in the response versus entries parsed.
+type SectionStats struct {
+	Seen   int `json:"seen"`            // Included entities whose $type mentions the section
+	Parsed int `json:"parsed"`          // Entries the parser produced
+	Total  int `json:"total,omitempty"` // Entries the profile reports having, 0 when not paged
+}
+
+// ParseStats reports parse coverage per profile section. Seen exceeding Parsed
+// usually means LinkedIn changed a $type string the parser matches on. Total exceeding
+// Seen means the response only embedded the first page of the section; see
+// Config.FetchAllSections.
+type ParseStats struct {
+	Experience     SectionStats `json:"experience"`
+	Education      SectionStats `json:"education"`
+	Skills         SectionStats `json:"skills"`
+	Certifications SectionStats `json:"certifications"`
+}
+
+// ProfileResult is the result of GetProfileVerbose.
+type ProfileResult struct {
+	Profile *LinkedInProfile `json:"profile"`
+	Stats   ParseStats       `json:"stats"`
+}
+
 // ProfileLocation represents detailed location information
 type ProfileLocation struct {
 	CountryCode       string `json:"countryCode,omitempty"`
@@ -84,7 +217,13 @@ type ProfilePicture struct {
 	DisplayImageUrn    string `json:"displayImageUrn,omitempty"`
 	PhotoFilterPicture string `json:"photoFilterPicture,omitempty"`
 	RootURL            string `json:"rootUrl,omitempty"`
-	A11yText           string `json:"a11yText,omitempty"`
+	// URL is the signed URL of the largest artifact, RootURL joined with its path
+	// segment. Empty when the response lists no artifacts.
+	URL      string `json:"url,omitempty"`
+	A11yText string `json:"a11yText,omitempty"`
+	// ExpiresAt is the earliest artifact expiry as a unix timestamp in milliseconds,
+	// after which the signed picture URL stops resolving. Zero when unknown.
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
 }
 
 // ConnectionInfo represents connection and following information
@@ -105,21 +244,59 @@ type LinkedInProfile struct {
 	Headline         string `json:"headline,omitempty"`         // e.g., "Investor at Bertram Capital"
 	Location         string `json:"location,omitempty"`         // e.g., "San Francisco, CA"
 	ProfileURL       string `json:"profileUrl,omitempty"`       // e.g., "https://www.linkedin.com/in/nic-sanchez-a8516a54?..."
+	// TrackingID is the search view model's trackingId, which LinkedIn uses to correlate
+	// impressions and clicks. Only set for search results that carry one.
+	TrackingID string `json:"trackingId,omitempty"`
+	// FetchedAt is when the client fetched the profile, for caching decisions. It is nil
+	// for profiles parsed offline, e.g. with ParseFromJSON.
+	FetchedAt *time.Time `json:"fetchedAt,omitempty"`
+	// Provenance records the request that produced the profile. It is only set by clients
+	// created with WithProvenance.
+	Provenance *Provenance `json:"provenance,omitempty"`
+	// CurrentCompany is the company of the member's current position: the first ongoing
+	// one, else the most recent. Set on full and lite profiles, empty on search results.
+	CurrentCompany string `json:"currentCompany,omitempty"`
+	// SubtitleInsight holds a search result's secondary subtitle when it is not a location,
+	// e.g. "Current: VP Sales at Acme" or "Past: Analyst at Former Corp".
+	SubtitleInsight string `json:"subtitleInsight,omitempty"`
+	// NetworkDistance is the viewer's connection degree to the member as a NetworkFilter
+	// code ("F", "S" or "O"), empty when unknown or for the viewer's own profile.
+	NetworkDistance NetworkFilter `json:"networkDistance,omitempty"`
+	// MutualConnectionsCount is the number of connections shared with the viewer.
+	MutualConnectionsCount int `json:"mutualConnectionsCount,omitempty"`
 
 	// Extended fields for detailed profile data
 	FirstName string `json:"firstName,omitempty"`
 	LastName  string `json:"lastName,omitempty"`
 	Summary   string `json:"summary,omitempty"`
 	Industry  string `json:"industry,omitempty"`
+	// IndustryURN is the profile's industry reference (e.g. "urn:li:fsd_industry:43"),
+	// captured even when no human-readable Industry name can be resolved.
+	IndustryURN string `json:"industryUrn,omitempty"`
+	// MultiLocaleHeadline holds the headline in each locale the member wrote it in, keyed
+	// like "en_US". Use HeadlineForLocale to pick one with fallback.
+	MultiLocaleHeadline map[string]string `json:"multiLocaleHeadline,omitempty"`
+	// Locale is the language variant the profile was fetched in with GetProfileLocalized,
+	// e.g. "ja_JP"; empty for the member's default version.
+	Locale string `json:"locale,omitempty"`
+	// Occupation is LinkedIn's computed occupation, typically "Title at Company" from the
+	// current position, which can differ from the member-written Headline.
+	Occupation string `json:"occupation,omitempty"`
 
 	// Location details
+	DisplayLocation string           `json:"displayLocation,omitempty"` // Top-card text, e.g. "Greater Seattle Area"
 	LocationDetails *ProfileLocation `json:"locationDetails,omitempty"`
 
 	// Professional information
 	Experience     []Experience    `json:"experience,omitempty"`
 	Education      []Education     `json:"education,omitempty"`
 	Skills         []Skill         `json:"skills,omitempty"`
+	TopSkills      []string        `json:"topSkills,omitempty"` // Skills shown on the top card, in display order
 	Certifications []Certification `json:"certifications,omitempty"`
+	Patents        []Patent        `json:"patents,omitempty"`
+	Publications   []Publication   `json:"publications,omitempty"`
+	Services       []string        `json:"services,omitempty"` // Services offered on the profile's services page
+	Interests      *Interests      `json:"interests,omitempty"`
 
 	// Profile media and presentation
 	ProfilePicture     *ProfilePicture `json:"profilePicture,omitempty"`
@@ -132,9 +309,18 @@ type LinkedInProfile struct {
 	IsPremium      bool            `json:"isPremium,omitempty"`
 
 	// Additional metadata
-	IsMemorialized  bool   `json:"isMemorialized,omitempty"`
+	IsMemorialized bool `json:"isMemorialized,omitempty"`
+	// IsPrivate marks an out-of-network member LinkedIn shows in private mode, with the
+	// "LinkedIn Member" placeholder instead of a name or without any identifier or
+	// headline. The placeholder is not kept as the name, so FullName is empty.
+	IsPrivate       bool   `json:"isPrivate,omitempty"`
 	TempStatus      string `json:"tempStatus,omitempty"`
 	TempStatusEmoji string `json:"tempStatusEmoji,omitempty"`
+	// OpenTo lists every "open to" state the member shows, such as OpenToWork,
+	// OpenToHiring or OpenToMentoring, gathered from the open-to preferences, the
+	// #OpenToWork or #Hiring photo frame, TempStatus and offered services. Categories
+	// without a constant are kept lowercased.
+	OpenTo []string `json:"openTo,omitempty"`
 
 	// Activity and engagement
 	CreatorWebsite string `json:"creatorWebsite,omitempty"`
@@ -142,15 +328,6 @@ type LinkedInProfile struct {
 	// Degree string `json:"degree,omitempty"` // e.g. "• 2nd", could be parsed from badgeText
 }
 
-const (
-	EntityTypeProfile       = "com.linkedin.voyager.dash.identity.profile.Profile"
-	EntityTypePosition      = "com.linkedin.voyager.dash.identity.profile.Position"
-	EntityTypeEducation     = "com.linkedin.voyager.dash.identity.profile.Education"
-	EntityTypeEndorsedSkill = "EndorsedSkill"
-	EntityTypeConnection    = "Connection"
-	EntityTypeFollowing     = "Following"
-)
-
 // SearchQueryParameters represents a single key-value pair for query parameters
 // within the search query.
 type SearchQueryParameters struct {
@@ -173,6 +350,8 @@ type SearchVariables struct {
 	Count  int                 `json:"count"`  // e.g., 1 (present in cURL, added here)
 	Origin string              `json:"origin"` // e.g., "FACETED_SEARCH"
 	Query  SearchQuerySubQuery `json:"query"`
+	// PaginationToken continues a search from a "see all results" cluster; omitted when empty.
+	PaginationToken string `json:"paginationToken,omitempty"`
 	// The 'includeFiltersInResponse' field in the user's original spec for SearchVariables
 	// is not present at this top level in the provided cURL. It's inside the 'Query' sub-object.
 	// If it were needed at this level, it would be:
@@ -181,17 +360,19 @@ type SearchVariables struct {
 
 // --- API Response Structures (to be refined in Step 4 as per your plan) ---
 
-// FlexibleText is a custom type to handle fields that can be either a string or a TextObject
+// FlexibleText is a custom type to handle fields that can be either a string or a TextObject.
+// It is always used as a value: a null, missing, "" or {"text":""} text all decode to "",
+// so code checks for the empty string rather than telling those cases apart.
 type FlexibleText string
 
 // UnmarshalJSON implements custom unmarshaling logic for FlexibleText.
 // It tries to unmarshal into a TextObject first, and falls back to a string.
 func (ft *FlexibleText) UnmarshalJSON(data []byte) error {
-	// 1. Try to unmarshal into a standard TextObject
+	// 1. Try to unmarshal into a standard TextObject, whose text may be empty
 	var textObj struct {
 		Text string `json:"text"`
 	}
-	if err := json.Unmarshal(data, &textObj); err == nil && textObj.Text != "" {
+	if err := json.Unmarshal(data, &textObj); err == nil && string(data) != "null" {
 		*ft = FlexibleText(textObj.Text)
 		return nil
 	}
@@ -212,6 +393,43 @@ func (ft *FlexibleText) UnmarshalJSON(data []byte) error {
 	return fmt.Errorf("cannot unmarshal %s into FlexibleText", string(data))
 }
 
+// FlexibleInt is a custom type for counts that LinkedIn sometimes sends as JSON
+// strings (e.g. "1200" or "1,200") instead of numbers.
+type FlexibleInt int64
+
+// UnmarshalJSON implements custom unmarshaling logic for FlexibleInt.
+// It accepts a JSON number, a numeric string (optionally with thousands separators) or null.
+func (fi *FlexibleInt) UnmarshalJSON(data []byte) error {
+	// 1. Try to unmarshal into a number
+	var n int64
+	if err := json.Unmarshal(data, &n); err == nil {
+		*fi = FlexibleInt(n)
+		return nil
+	}
+
+	// 2. Try to unmarshal into a numeric string
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		s = strings.ReplaceAll(strings.TrimSpace(s), ",", "")
+		if s == "" {
+			*fi = 0
+			return nil
+		}
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			*fi = FlexibleInt(n)
+			return nil
+		}
+	}
+
+	// 3. If it's a JSON null, treat it as zero
+	if string(data) == "null" {
+		*fi = 0
+		return nil
+	}
+
+	return fmt.Errorf("cannot unmarshal %s into FlexibleInt", string(data))
+}
+
 // TextObject is a common structure in LinkedIn's API for text fields.
 type TextObject struct {
 	Text string `json:"text"`
@@ -235,15 +453,40 @@ type ClusterMetadata struct {
 type Item struct {
 	EntityResultURN string `json:"*entityResult"` // URN for EntityResultViewModel
 	FeedbackCardURN string `json:"*feedbackCard"` // URN for FeedbackCard
+	// SeeAllResults is set on the items of a "see all results" cluster, which point to a
+	// continuation of the search rather than to an entity result.
+	SeeAllResults *SeeAllResults `json:"seeAllResults,omitempty"`
 	// Other types of URNs or direct data might appear here
 }
 
+// UnmarshalJSON accepts the item both bare and wrapped in an "item" object, as
+// GraphQL search responses nest it: {"item": {"*entityResult": "urn:li:..."}}.
+func (i *Item) UnmarshalJSON(data []byte) error {
+	type plainItem Item
+	var wrapper struct {
+		Item *plainItem `json:"item"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return err
+	}
+	if wrapper.Item != nil {
+		*i = Item(*wrapper.Item)
+		return nil
+	}
+	return json.Unmarshal(data, (*plainItem)(i))
+}
+
+// SeeAllResults is the continuation a "see all results" cluster item carries.
+type SeeAllResults struct {
+	PaginationToken string `json:"paginationToken"`
+}
+
 // ClusterElement represents a cluster of search results.
 type ClusterElement struct {
-	Items    []Item        `json:"items"`
-	Position int           `json:"position"`
-	Image    *string       `json:"image"` // Using pointer for nullable
-	Title    *FlexibleText `json:"title"` // Using pointer for nullable text object
+	Items    []Item       `json:"items"`
+	Position int          `json:"position"`
+	Image    *string      `json:"image"` // Using pointer for nullable
+	Title    FlexibleText `json:"title"`
 	// Other cluster fields can be added here
 }
 
@@ -284,6 +527,7 @@ type IncludedProfile struct {
 	FirstName        string `json:"firstName,omitempty"`
 	LastName         string `json:"lastName,omitempty"`
 	Headline         string `json:"headline,omitempty"`
+	IndustryURN      string `json:"*industryV2,omitempty"`
 	// Other profile-specific fields
 }
 
@@ -305,19 +549,39 @@ type GenericIncludedElement struct {
 	// Embed other fields that are common or use json.RawMessage to unmarshal specific data later
 	// For simplicity, we'll assume specific unmarshalling based on $type happens after this stage.
 	// The fields below are from EntityResultViewModel for direct unmarshalling if $type matches.
-	EntityURN         string        `json:"entityUrn,omitempty"`
-	TrackingURN       string        `json:"trackingUrn,omitempty"`
-	Title             *FlexibleText `json:"title,omitempty"`
-	PrimarySubtitle   *FlexibleText `json:"primarySubtitle,omitempty"`
-	SecondarySubtitle *FlexibleText `json:"secondarySubtitle,omitempty"`
-	NavigationURL     string        `json:"navigationUrl,omitempty"`
-	BadgeText         *FlexibleText `json:"badgeText,omitempty"`
+	EntityURN         string       `json:"entityUrn,omitempty"`
+	TrackingURN       string       `json:"trackingUrn,omitempty"`
+	Title             FlexibleText `json:"title,omitempty"`
+	PrimarySubtitle   FlexibleText `json:"primarySubtitle,omitempty"`
+	SecondarySubtitle FlexibleText `json:"secondarySubtitle,omitempty"`
+	NavigationURL     string       `json:"navigationUrl,omitempty"`
+	BadgeText         FlexibleText `json:"badgeText,omitempty"`
 
 	// Fields from Profile type
-	PublicIdentifier string `json:"publicIdentifier,omitempty"`
-	FirstName        string `json:"firstName,omitempty"`
-	LastName         string `json:"lastName,omitempty"`
-	Headline         string `json:"headline,omitempty"` // Note: Profile also has a headline
+	PublicIdentifier    string            `json:"publicIdentifier,omitempty"`
+	FirstName           string            `json:"firstName,omitempty"`
+	LastName            string            `json:"lastName,omitempty"`
+	Headline            string            `json:"headline,omitempty"`            // Note: Profile also has a headline
+	MultiLocaleHeadline map[string]string `json:"multiLocaleHeadline,omitempty"` // Keyed by locale, e.g. "fr_FR"
+	Summary             string            `json:"summary,omitempty"`             // The About section
+	// Names and summary per locale, for profiles maintained in several languages
+	MultiLocaleFirstName map[string]string          `json:"multiLocaleFirstName,omitempty"`
+	MultiLocaleLastName  map[string]string          `json:"multiLocaleLastName,omitempty"`
+	MultiLocaleSummary   map[string]string          `json:"multiLocaleSummary,omitempty"`
+	Occupation           string                     `json:"occupation,omitempty"` // Computed from the current position
+	ProfilePicture       *ProfilePictureResponse    `json:"profilePicture,omitempty"`
+	IndustryURN          string                     `json:"*industryV2,omitempty"`
+	PrimaryLocale        *LocaleResponse            `json:"primaryLocale,omitempty"`
+	ObjectURN            string                     `json:"objectUrn,omitempty"` // e.g., "urn:li:member:123456"
+	TempStatus           string                     `json:"tempStatus,omitempty"`
+	TempStatusEmoji      string                     `json:"tempStatusEmoji,omitempty"`
+	OpenToPreferences    []OpenToPreferenceResponse `json:"openToPreferences,omitempty"`
+	GeoLocation          *GeoLocationResponse       `json:"geoLocation,omitempty"`
+	ProfilePositions     *PositionsCollection       `json:"profilePositions,omitempty"`  // May embed only the first page
+	ProfileEducations    *EducationCollection       `json:"profileEducations,omitempty"` // May embed only the first page
+
+	// Fields from Geo
+	DefaultLocalizedName string `json:"defaultLocalizedName,omitempty"` // e.g., "Greater Seattle Area"
 
 	// Fields from PositionResponse
 	CompanyName  string             `json:"companyName,omitempty"`
@@ -332,14 +596,42 @@ type GenericIncludedElement struct {
 	DegreeName   string `json:"degreeName,omitempty"`
 	FieldOfStudy string `json:"fieldOfStudy,omitempty"`
 	Activities   string `json:"activities,omitempty"`
+	Grade        string `json:"grade,omitempty"`
 
 	// Fields from Skill
-	Name             string `json:"name,omitempty"`
-	EndorsementCount int    `json:"endorsementCount,omitempty"`
-	EndorsedByViewer bool   `json:"endorsedByViewer,omitempty"`
+	Name             string      `json:"name,omitempty"`
+	EndorsementCount FlexibleInt `json:"endorsementCount,omitempty"`
+	EndorsedByViewer bool        `json:"endorsedByViewer,omitempty"`
 
 	// Fields from FeedbackCard
 	TrackingId string `json:"trackingId,omitempty"`
+
+	// Fields from Certification (the name is carried in Name)
+	Authority     string `json:"authority,omitempty"`
+	LicenseNumber string `json:"licenseNumber,omitempty"`
+
+	// Fields from Patent
+	Number   string        `json:"number,omitempty"`
+	Issuer   string        `json:"issuer,omitempty"`
+	IssuedOn *DateResponse `json:"issuedOn,omitempty"`
+	URL      string        `json:"url,omitempty"`
+
+	// Fields from Publication (the title is carried in Name)
+	Publisher   string        `json:"publisher,omitempty"`
+	PublishedOn *DateResponse `json:"publishedOn,omitempty"`
+
+	// Fields from MemberRelationship
+	MemberDistance         *MemberDistanceResponse `json:"memberDistance,omitempty"`
+	SharedConnectionsCount FlexibleInt             `json:"sharedConnectionsCount,omitempty"`
+
+	// Fields from ServiceProvider
+	ServiceCategories []ServiceCategoryResponse `json:"serviceCategories,omitempty"`
+
+	// Fields from ProfileTopSkills
+	TopSkills []TopSkillResponse `json:"topSkills,omitempty"`
+
+	// Fields from Interest (the followed entity's name is carried in Name)
+	InterestType string `json:"interestType,omitempty"` // COMPANY, INFLUENCER, HASHTAG or SCHOOL
 }
 
 // SearchAPIResponse is the top-level structure for the entire API JSON response.
@@ -349,6 +641,16 @@ type SearchAPIResponse struct {
 	Included []GenericIncludedElement `json:"included"` // This will hold various types of objects
 	// Meta interface{} `json:"meta"` // The meta field contains microSchema, can be added if needed
 	// Extensions interface{} `json:"extensions"` // The extensions field, can be added if needed
+
+	provenance *Provenance // Request that produced the response, set with WithProvenance
+}
+
+// Provenance identifies the GraphQL request a profile was parsed from, to correlate an
+// odd result with the exact query that produced it.
+type Provenance struct {
+	Operation string `json:"operation"`           // e.g. "GetProfile" or "SearchProfiles"
+	QueryID   string `json:"queryId"`             // The query ID that answered, after any fallback
+	Variables string `json:"variables,omitempty"` // The Rest.li variables as sent, e.g. "(vanityName:jane-doe)"
 }
 
 // --- Profile API Response Structures ---
@@ -385,6 +687,7 @@ type ProfileResponseEntity struct {
 	FirstName           string                    `json:"firstName,omitempty"`
 	LastName            string                    `json:"lastName,omitempty"`
 	Headline            string                    `json:"headline,omitempty"`
+	Occupation          string                    `json:"occupation,omitempty"`
 	PublicIdentifier    string                    `json:"publicIdentifier,omitempty"`
 	Location            *ProfileLocationResponse  `json:"location,omitempty"`
 	ProfilePicture      *ProfilePictureResponse   `json:"profilePicture,omitempty"`
@@ -403,6 +706,39 @@ type ProfileResponseEntity struct {
 	Type                string                    `json:"$type,omitempty"`
 }
 
+// OpenToPreferenceResponse is one "open to" category on a profile, e.g.
+// {"category":"MENTORING","active":true}.
+type OpenToPreferenceResponse struct {
+	Category string `json:"category"`
+	Active   bool   `json:"active"`
+}
+
+// LocaleResponse represents a LinkedIn locale, e.g. {"country":"JP","language":"ja"}
+type LocaleResponse struct {
+	Country  string `json:"country,omitempty"`
+	Language string `json:"language,omitempty"`
+}
+
+// ServiceCategoryResponse is one service a member offers, e.g. {"name":"Web Development"}
+type ServiceCategoryResponse struct {
+	Name string `json:"name,omitempty"`
+}
+
+// TopSkillResponse is one skill on the profile top card, e.g. {"name":"Go"}
+type TopSkillResponse struct {
+	Name string `json:"name,omitempty"`
+}
+
+// GeoLocationResponse represents a profile's reference to its Geo entity
+type GeoLocationResponse struct {
+	GeoURN string `json:"*geo,omitempty"` // e.g., "urn:li:fsd_geo:90000091"
+}
+
+// MemberDistanceResponse is the viewer's connection degree, e.g. {"value": "DISTANCE_2"}.
+type MemberDistanceResponse struct {
+	Value string `json:"value,omitempty"`
+}
+
 // ProfileLocationResponse represents location data from API response
 type ProfileLocationResponse struct {
 	CountryCode       string   `json:"countryCode,omitempty"`
@@ -424,6 +760,97 @@ type ProfilePictureResponse struct {
 	Type                           string               `json:"$type,omitempty"`
 }
 
+// CompanyAPIResponse represents the response from the company query.
+type CompanyAPIResponse struct {
+	Included []CompanyResponse `json:"included,omitempty"`
+}
+
+// CompanyResponse represents a Company entity from the "included" array.
+type CompanyResponse struct {
+	Type          string `json:"$type"`
+	EntityURN     string `json:"entityUrn,omitempty"`     // e.g., "urn:li:fsd_company:1001"
+	Name          string `json:"name,omitempty"`          // e.g., "Acme Capital"
+	UniversalName string `json:"universalName,omitempty"` // e.g., "acme-capital"
+}
+
+// CompanyInsights holds the Premium insights of a company. Fields LinkedIn does not
+// report for the company are left zero.
+type CompanyInsights struct {
+	CompanyURN    string `json:"companyUrn"`              // e.g., "urn:li:fsd_company:1001"
+	EmployeeCount int    `json:"employeeCount,omitempty"` // Employees on LinkedIn
+	// EmployeeGrowth6Mo is the headcount change over the last six months in percent,
+	// e.g. 12.5 for 12.5% growth. Nil when LinkedIn reports no six-month period.
+	EmployeeGrowth6Mo *float64 `json:"employeeGrowth6Mo,omitempty"`
+	// MedianTenure is the median employee tenure in years.
+	MedianTenure      float64             `json:"medianTenure,omitempty"`
+	FunctionBreakdown []FunctionHeadcount `json:"functionBreakdown,omitempty"` // Largest functions first
+}
+
+// FunctionHeadcount is the headcount of one job function within a company.
+type FunctionHeadcount struct {
+	Function   string  `json:"function"`             // e.g., "Engineering"
+	Count      int     `json:"count"`                // Employees in the function
+	Percentage float64 `json:"percentage,omitempty"` // Share of all employees, e.g. 32.5
+}
+
+// CompanyInsightsAPIResponse represents the response from the company insights query.
+type CompanyInsightsAPIResponse struct {
+	Included []CompanyInsightsResponse `json:"included,omitempty"`
+}
+
+// CompanyInsightsResponse represents a CompanyInsights entity, or the Premium upsell
+// shown instead of it, from the "included" array.
+type CompanyInsightsResponse struct {
+	Type                      string                             `json:"$type"`
+	EntityURN                 string                             `json:"entityUrn,omitempty"` // e.g., "urn:li:fsd_companyInsights:1001"
+	HeadcountInsights         *HeadcountInsightsResponse         `json:"headcountInsights,omitempty"`
+	FunctionHeadcountInsights *FunctionHeadcountInsightsResponse `json:"functionHeadcountInsights,omitempty"`
+	MedianTenureYears         float64                            `json:"medianTenureYears,omitempty"`
+}
+
+// HeadcountInsightsResponse holds a company's total headcount and its change over time.
+type HeadcountInsightsResponse struct {
+	TotalEmployees FlexibleInt             `json:"totalEmployees"`
+	GrowthPeriods  []HeadcountGrowthPeriod `json:"growthPeriods,omitempty"`
+}
+
+// HeadcountGrowthPeriod is the headcount change over the trailing MonthDifference months.
+type HeadcountGrowthPeriod struct {
+	MonthDifference  int     `json:"monthDifference"`  // e.g., 6
+	ChangePercentage float64 `json:"changePercentage"` // e.g., 12.5, negative when shrinking
+}
+
+// FunctionHeadcountInsightsResponse holds a company's latest headcount per job function.
+type FunctionHeadcountInsightsResponse struct {
+	LatestHeadcountByFunction []FunctionHeadcountResponse `json:"latestHeadcountByFunction,omitempty"`
+}
+
+// FunctionHeadcountResponse is the headcount of one job function.
+type FunctionHeadcountResponse struct {
+	FunctionName       string      `json:"functionName"`
+	FunctionCount      FlexibleInt `json:"functionCount"`
+	FunctionPercentage float64     `json:"functionPercentage,omitempty"`
+}
+
+// MiniProfileResponse represents the compact MiniProfile entity found in feed and
+// notification responses. Its occupation is the member's headline.
+type MiniProfileResponse struct {
+	Type             string                      `json:"$type"`
+	EntityURN        string                      `json:"entityUrn,omitempty"`
+	ObjectURN        string                      `json:"objectUrn,omitempty"`
+	PublicIdentifier string                      `json:"publicIdentifier,omitempty"`
+	FirstName        string                      `json:"firstName,omitempty"`
+	LastName         string                      `json:"lastName,omitempty"`
+	Occupation       string                      `json:"occupation,omitempty"`
+	TrackingID       string                      `json:"trackingId,omitempty"`
+	Picture          *MiniProfilePictureResponse `json:"picture,omitempty"`
+}
+
+// MiniProfilePictureResponse is the union wrapper around a MiniProfile's picture.
+type MiniProfilePictureResponse struct {
+	VectorImage *VectorImageResponse `json:"com.linkedin.common.VectorImage,omitempty"`
+}
+
 // VectorImageResponse represents vector image data from API response
 type VectorImageResponse struct {
 	RootURL           string                   `json:"rootUrl,omitempty"`
@@ -463,12 +890,12 @@ type PagingInfoResponse struct {
 
 // FollowingStateResponse represents following state data
 type FollowingStateResponse struct {
-	EntityURN     string   `json:"entityUrn,omitempty"`
-	Following     bool     `json:"following,omitempty"`
-	FollowerCount int64    `json:"followerCount,omitempty"`
-	FolloweeCount int64    `json:"followeeCount,omitempty"`
-	RecipeTypes   []string `json:"$recipeTypes,omitempty"`
-	Type          string   `json:"$type,omitempty"`
+	EntityURN     string      `json:"entityUrn,omitempty"`
+	Following     bool        `json:"following,omitempty"`
+	FollowerCount FlexibleInt `json:"followerCount,omitempty"`
+	FolloweeCount FlexibleInt `json:"followeeCount,omitempty"`
+	RecipeTypes   []string    `json:"$recipeTypes,omitempty"`
+	Type          string      `json:"$type,omitempty"`
 }
 
 // PositionsCollection represents a collection of position/experience data
@@ -512,6 +939,7 @@ type EducationResponse struct {
 	DateRange    *DateRangeResponse `json:"dateRange,omitempty"`
 	Description  string             `json:"description,omitempty"`
 	Activities   string             `json:"activities,omitempty"`
+	Grade        string             `json:"grade,omitempty"`
 	RecipeTypes  []string           `json:"$recipeTypes,omitempty"`
 	Type         string             `json:"$type,omitempty"`
 }
@@ -560,3 +988,88 @@ type TextViewModelResponse struct {
 }
 
 // --- Search API Response Structures (existing) ---
+
+// --- Feed API Response Structures ---
+
+// Post represents a post shared by a member, as returned by GetProfilePosts.
+type Post struct {
+	URN          string    `json:"urn,omitempty"` // e.g., "urn:li:activity:7180000000000000000"
+	Text         string    `json:"text,omitempty"`
+	PostedAt     time.Time `json:"postedAt,omitempty"` // Decoded from the activity ID
+	LikeCount    int       `json:"likeCount,omitempty"`
+	CommentCount int       `json:"commentCount,omitempty"`
+	URL          string    `json:"url,omitempty"`
+}
+
+// ActivityType is the kind of engagement an Activity records.
+type ActivityType = string
+
+// Known activity types.
+const (
+	ActivityTypeLike    ActivityType = "LIKE"    // Any reaction: like, celebrate, support, ...
+	ActivityTypeComment ActivityType = "COMMENT" // A comment on someone's update
+	ActivityTypeShare   ActivityType = "SHARE"   // A repost, with or without commentary
+)
+
+// Activity is a member's engagement with an update, as returned by GetProfileActivity.
+type Activity struct {
+	Type      ActivityType `json:"type"`
+	TargetURN string       `json:"targetUrn"` // The activity engaged with, e.g. "urn:li:activity:7180000000000000000"
+	// CreatedAt is when the member engaged. LinkedIn does not expose it for reactions,
+	// so it is the zero time for LIKE activities.
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	Text      string    `json:"text,omitempty"` // The comment, or the commentary added to a repost
+}
+
+// ProfileActivityAPIResponse represents the response from the profile activity query. Its
+// data section only lists update URNs, so just the included entities are decoded.
+type ProfileActivityAPIResponse struct {
+	Included []FeedIncludedElement `json:"included,omitempty"`
+}
+
+// ProfileUpdatesAPIResponse represents the response from the profile updates query.
+type ProfileUpdatesAPIResponse struct {
+	Data     ProfileUpdatesData    `json:"data"`
+	Included []FeedIncludedElement `json:"included,omitempty"`
+}
+
+// ProfileUpdatesData represents the data section of the profile updates response.
+type ProfileUpdatesData struct {
+	Data struct {
+		FeedDashProfileUpdatesByMemberShareFeed struct {
+			Elements []string            `json:"*elements,omitempty"`
+			Paging   *PagingInfoResponse `json:"paging,omitempty"`
+		} `json:"feedDashProfileUpdatesByMemberShareFeed"`
+	} `json:"data"`
+}
+
+// FeedIncludedElement is used to unmarshal Update and SocialActivityCounts entities
+// from the "included" array of feed responses.
+type FeedIncludedElement struct {
+	Type      string `json:"$type"`
+	EntityURN string `json:"entityUrn,omitempty"`
+
+	// Fields from Update
+	Metadata *struct {
+		BackendURN string `json:"backendUrn,omitempty"` // e.g., "urn:li:activity:..."
+	} `json:"metadata,omitempty"`
+	Commentary *struct {
+		Text FlexibleText `json:"text"`
+	} `json:"commentary,omitempty"`
+	SocialContent *struct {
+		ShareURL string `json:"shareUrl,omitempty"`
+	} `json:"socialContent,omitempty"`
+	// Header is set on updates surfaced by someone's engagement, e.g. "Jane Doe likes this".
+	Header *struct {
+		Text FlexibleText `json:"text"`
+	} `json:"header,omitempty"`
+	ResharedUpdate string `json:"*resharedUpdate,omitempty"` // Entity URN of the reposted update
+
+	// Fields from Comment (which also uses Commentary)
+	CreatedAt FlexibleInt `json:"createdAt,omitempty"` // Unix milliseconds
+
+	// Fields from SocialActivityCounts
+	URN         string      `json:"urn,omitempty"` // The activity URN the counts belong to, or the comment URN
+	NumLikes    FlexibleInt `json:"numLikes,omitempty"`
+	NumComments FlexibleInt `json:"numComments,omitempty"`
+}
@@ -0,0 +1,67 @@
+package linkedinscraper_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("Models", func() {
+	Describe("FlexibleText", func() {
+		DescribeTable("decodes text objects and strings",
+			func(raw string, expected linkedinscraper.FlexibleText) {
+				var text linkedinscraper.FlexibleText
+				Expect(json.Unmarshal([]byte(raw), &text)).To(Succeed())
+				Expect(text).To(Equal(expected))
+			},
+			Entry("text object", `{"text":"Jane Doe"}`, linkedinscraper.FlexibleText("Jane Doe")),
+			Entry("string", `"Jane Doe"`, linkedinscraper.FlexibleText("Jane Doe")),
+			Entry("empty text object", `{"text":""}`, linkedinscraper.FlexibleText("")),
+			Entry("object without text", `{"accessibilityText":"Jane"}`, linkedinscraper.FlexibleText("")),
+			Entry("empty string", `""`, linkedinscraper.FlexibleText("")),
+			Entry("null", `null`, linkedinscraper.FlexibleText("")),
+		)
+	})
+
+	Describe("FlexibleInt", func() {
+		DescribeTable("accepts both number and string forms",
+			func(raw string, expected int64) {
+				var n linkedinscraper.FlexibleInt
+				Expect(json.Unmarshal([]byte(raw), &n)).To(Succeed())
+				Expect(int64(n)).To(Equal(expected))
+			},
+			Entry("number", `1200`, int64(1200)),
+			Entry("string", `"1200"`, int64(1200)),
+			Entry("string with separator", `"1,200"`, int64(1200)),
+			Entry("padded string", `" 1200 "`, int64(1200)),
+			Entry("empty string", `""`, int64(0)),
+			Entry("null", `null`, int64(0)),
+		)
+
+		DescribeTable("rejects non-numeric values",
+			func(raw string) {
+				var n linkedinscraper.FlexibleInt
+				Expect(json.Unmarshal([]byte(raw), &n)).NotTo(Succeed())
+			},
+			Entry("word", `"many"`),
+			Entry("float", `12.5`),
+			Entry("object", `{"count":1200}`),
+		)
+
+		It("parses string endorsement counts in profile responses", func() {
+			skill := map[string]interface{}{
+				"$type":            "com.linkedin.voyager.dash.identity.profile.EndorsedSkill",
+				"entityUrn":        "urn:li:fsd_skill:(ACoAAAsomeone,1)",
+				"name":             "Go",
+				"endorsementCount": "1200",
+			}
+			profile, err := linkedinscraper.ParseFromJSON(profileResponseJSON(profileEntity("someone", "Jane", "Doe"), skill))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Skills).To(HaveLen(1))
+			Expect(profile.Skills[0].EndorsementCount).To(Equal(1200))
+		})
+	})
+})
@@ -0,0 +1,168 @@
+package linkedinscraper
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// WriteProfilesParquet writes profiles to w as a Parquet file for loading into a data
+// warehouse. Nested sections become LIST columns instead of being joined into text, so
+// nothing is lost as it would be in CSV. The schema is stable, new columns are only ever
+// appended:
+//
+//	public_identifier, urn, full_name, first_name, last_name, headline, location,
+//	profile_url, industry, summary, current_company   string
+//	connection_count, follower_count                    int64
+//	experience  list<struct<title, company_name, company_urn, location_name,
+//	            description string, start_year, start_month, end_year, end_month int32>>
+//	education   list<struct<school_name, degree_name, field_of_study string,
+//	            start_year, end_year int32>>
+//	skills      list<struct<name string, endorsement_count int64>>
+//	top_skills, services   list<string>
+//
+// Every column is required: missing text is "" and unknown numbers, including the end
+// date of an ongoing role, are 0. The file is written with parquet-go and holds a single
+// row group.
+func WriteProfilesParquet(w io.Writer, profiles []LinkedInProfile) error {
+	rows := make([]profileParquetRow, len(profiles))
+	for i := range profiles {
+		rows[i] = newProfileParquetRow(&profiles[i])
+	}
+	if err := parquet.Write(w, rows); err != nil {
+		return fmt.Errorf("failed to write parquet file: %w", err)
+	}
+	return nil
+}
+
+// profileParquetRow is the stable profile schema written by WriteProfilesParquet.
+// Append new fields at the end so existing column positions keep their meaning.
+type profileParquetRow struct {
+	PublicIdentifier string                     `parquet:"public_identifier"`
+	URN              string                     `parquet:"urn"`
+	FullName         string                     `parquet:"full_name"`
+	FirstName        string                     `parquet:"first_name"`
+	LastName         string                     `parquet:"last_name"`
+	Headline         string                     `parquet:"headline"`
+	Location         string                     `parquet:"location"`
+	ProfileURL       string                     `parquet:"profile_url"`
+	Industry         string                     `parquet:"industry"`
+	Summary          string                     `parquet:"summary"`
+	CurrentCompany   string                     `parquet:"current_company"`
+	ConnectionCount  int64                      `parquet:"connection_count"`
+	FollowerCount    int64                      `parquet:"follower_count"`
+	Experience       []experienceParquetElement `parquet:"experience,list"`
+	Education        []educationParquetElement  `parquet:"education,list"`
+	Skills           []skillParquetElement      `parquet:"skills,list"`
+	TopSkills        []string                   `parquet:"top_skills,list"`
+	Services         []string                   `parquet:"services,list"`
+}
+
+type experienceParquetElement struct {
+	Title        string `parquet:"title"`
+	CompanyName  string `parquet:"company_name"`
+	CompanyURN   string `parquet:"company_urn"`
+	LocationName string `parquet:"location_name"`
+	Description  string `parquet:"description"`
+	StartYear    int32  `parquet:"start_year"`
+	StartMonth   int32  `parquet:"start_month"`
+	EndYear      int32  `parquet:"end_year"`
+	EndMonth     int32  `parquet:"end_month"`
+}
+
+type educationParquetElement struct {
+	SchoolName   string `parquet:"school_name"`
+	DegreeName   string `parquet:"degree_name"`
+	FieldOfStudy string `parquet:"field_of_study"`
+	StartYear    int32  `parquet:"start_year"`
+	EndYear      int32  `parquet:"end_year"`
+}
+
+type skillParquetElement struct {
+	Name             string `parquet:"name"`
+	EndorsementCount int64  `parquet:"endorsement_count"`
+}
+
+// newProfileParquetRow flattens p into its Parquet row.
+func newProfileParquetRow(p *LinkedInProfile) profileParquetRow {
+	row := profileParquetRow{
+		PublicIdentifier: p.PublicIdentifier,
+		URN:              p.URN,
+		FullName:         p.FullName,
+		FirstName:        p.FirstName,
+		LastName:         p.LastName,
+		Headline:         p.Headline,
+		Location:         p.Location,
+		ProfileURL:       p.ProfileURL,
+		Industry:         p.Industry,
+		Summary:          p.Summary,
+		CurrentCompany:   p.currentCompany(),
+		Experience:       make([]experienceParquetElement, len(p.Experience)),
+		Education:        make([]educationParquetElement, len(p.Education)),
+		Skills:           make([]skillParquetElement, len(p.Skills)),
+		TopSkills:        p.TopSkills,
+		Services:         p.Services,
+	}
+	if p.ConnectionInfo != nil {
+		row.ConnectionCount = int64(p.ConnectionInfo.ConnectionCount)
+		row.FollowerCount = int64(p.ConnectionInfo.FollowerCount)
+	}
+	for i, exp := range p.Experience {
+		start, end := rangeStart(exp.DateRange), rangeEnd(exp.DateRange)
+		row.Experience[i] = experienceParquetElement{
+			Title:        exp.Title,
+			CompanyName:  exp.CompanyName,
+			CompanyURN:   exp.CompanyURN,
+			LocationName: exp.LocationName,
+			Description:  exp.Description,
+			StartYear:    int32(dateYear(start)),
+			StartMonth:   int32(dateMonth(start)),
+			EndYear:      int32(dateYear(end)),
+			EndMonth:     int32(dateMonth(end)),
+		}
+	}
+	for i, edu := range p.Education {
+		row.Education[i] = educationParquetElement{
+			SchoolName:   edu.SchoolName,
+			DegreeName:   edu.DegreeName,
+			FieldOfStudy: edu.FieldOfStudy,
+			StartYear:    int32(dateYear(rangeStart(edu.DateRange))),
+			EndYear:      int32(dateYear(rangeEnd(edu.DateRange))),
+		}
+	}
+	for i, skill := range p.Skills {
+		row.Skills[i] = skillParquetElement{Name: skill.Name, EndorsementCount: int64(skill.EndorsementCount)}
+	}
+	return row
+}
+
+// dateYear and dateMonth return the parts of d, or 0 when d is nil.
+func dateYear(d *Date) int {
+	if d == nil {
+		return 0
+	}
+	return d.Year
+}
+
+func dateMonth(d *Date) int {
+	if d == nil {
+		return 0
+	}
+	return d.Month
+}
+
+// rangeStart and rangeEnd return the bounds of r, or nil when r is nil.
+func rangeStart(r *DateRange) *Date {
+	if r == nil {
+		return nil
+	}
+	return r.Start
+}
+
+func rangeEnd(r *DateRange) *Date {
+	if r == nil {
+		return nil
+	}
+	return r.End
+}
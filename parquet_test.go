@@ -0,0 +1,127 @@
+package linkedinscraper_test
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/parquet-go/parquet-go"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+// parquetProfile mirrors the columns of WriteProfilesParquet for reading files back.
+type parquetProfile struct {
+	PublicIdentifier string `parquet:"public_identifier"`
+	Headline         string `parquet:"headline"`
+	ConnectionCount  int64  `parquet:"connection_count"`
+	Experience       []struct {
+		Title     string `parquet:"title"`
+		StartYear int32  `parquet:"start_year"`
+		EndYear   int32  `parquet:"end_year"`
+	} `parquet:"experience,list"`
+	Skills []struct {
+		Name             string `parquet:"name"`
+		EndorsementCount int64  `parquet:"endorsement_count"`
+	} `parquet:"skills,list"`
+	TopSkills []string `parquet:"top_skills,list"`
+}
+
+var _ = Describe("WriteProfilesParquet", func() {
+	profiles := []linkedinscraper.LinkedInProfile{
+		{
+			PublicIdentifier: "jane-doe",
+			FullName:         "Jane Doe",
+			Headline:         "Partner at Acme Ventures",
+			ConnectionInfo:   &linkedinscraper.ConnectionInfo{ConnectionCount: 500},
+			Experience: []linkedinscraper.Experience{
+				{Title: "Partner", CompanyName: "Acme Ventures", DateRange: &linkedinscraper.DateRange{
+					Start: &linkedinscraper.Date{Year: 2020, Month: 1},
+				}},
+				{Title: "Analyst", CompanyName: "Big Bank", DateRange: &linkedinscraper.DateRange{
+					Start: &linkedinscraper.Date{Year: 2015}, End: &linkedinscraper.Date{Year: 2019, Month: 12},
+				}},
+			},
+			Skills:    []linkedinscraper.Skill{{Name: "Venture Capital", EndorsementCount: 42}},
+			TopSkills: []string{"Venture Capital", "Due Diligence"},
+		},
+		{
+			PublicIdentifier: "john-roe",
+			FullName:         "John Roe",
+		},
+	}
+
+	var data []byte
+
+	BeforeEach(func() {
+		var buf bytes.Buffer
+		Expect(linkedinscraper.WriteProfilesParquet(&buf, profiles)).To(Succeed())
+		data = buf.Bytes()
+	})
+
+	It("writes one row per profile", func() {
+		file, err := parquet.OpenFile(bytes.NewReader(data), int64(len(data)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(file.NumRows()).To(Equal(int64(2)))
+	})
+
+	It("round-trips scalar fields and nested sections", func() {
+		rows, err := parquet.Read[parquetProfile](bytes.NewReader(data), int64(len(data)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rows).To(HaveLen(2))
+
+		Expect(rows[0].PublicIdentifier).To(Equal("jane-doe"))
+		Expect(rows[0].Headline).To(Equal("Partner at Acme Ventures"))
+		Expect(rows[0].ConnectionCount).To(Equal(int64(500)))
+		Expect(rows[0].Experience).To(HaveLen(2))
+		Expect(rows[0].Experience[0].Title).To(Equal("Partner"))
+		Expect(rows[0].Experience[0].EndYear).To(BeZero())
+		Expect(rows[0].Experience[1].StartYear).To(Equal(int32(2015)))
+		Expect(rows[0].Experience[1].EndYear).To(Equal(int32(2019)))
+		Expect(rows[0].Skills).To(HaveLen(1))
+		Expect(rows[0].Skills[0].EndorsementCount).To(Equal(int64(42)))
+		Expect(rows[0].TopSkills).To(Equal([]string{"Venture Capital", "Due Diligence"}))
+
+		Expect(rows[1].PublicIdentifier).To(Equal("john-roe"))
+		Expect(rows[1].Headline).To(BeEmpty())
+		Expect(rows[1].Experience).To(BeEmpty())
+	})
+
+	It("describes the nested columns as standard LIST groups", func() {
+		file, err := parquet.OpenFile(bytes.NewReader(data), int64(len(data)))
+		Expect(err).NotTo(HaveOccurred())
+
+		var paths []string
+		for _, path := range file.Schema().Columns() {
+			paths = append(paths, strings.Join(path, "."))
+		}
+		Expect(paths[0]).To(Equal("public_identifier"))
+		Expect(paths).To(ContainElements(
+			"experience.list.element.title",
+			"skills.list.element.endorsement_count",
+			"top_skills.list.element",
+			"services.list.element",
+		))
+
+		lists := map[string]bool{}
+		for _, field := range file.Schema().Fields() {
+			if logical := field.Type().LogicalType(); logical != nil && logical.List != nil {
+				lists[field.Name()] = true
+			}
+		}
+		Expect(lists).To(HaveLen(5))
+		Expect(lists).To(HaveKey("experience"))
+		Expect(lists).To(HaveKey("top_skills"))
+	})
+
+	It("writes a valid empty file without profiles", func() {
+		var buf bytes.Buffer
+		Expect(linkedinscraper.WriteProfilesParquet(&buf, nil)).To(Succeed())
+
+		rows, err := parquet.Read[parquetProfile](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rows).To(BeEmpty())
+	})
+})
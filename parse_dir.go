@@ -0,0 +1,72 @@
+package linkedinscraper
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ParseProfilesFromDir runs ParseFromJSON on every .json file directly inside dir, using
+// a bounded pool of workers, and returns the parsed profiles in file name order along with
+// one error per file that could not be read or parsed. Each error names its file.
+func ParseProfilesFromDir(dir string) ([]*LinkedInProfile, []error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.EqualFold(filepath.Ext(entry.Name()), ".json") {
+			paths = append(paths, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(paths)
+
+	profiles := make([]*LinkedInProfile, len(paths))
+	errs := make([]error, len(paths))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(runtime.GOMAXPROCS(0), len(paths)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				profiles[i], errs[i] = parseProfileFile(paths[i])
+			}
+		}()
+	}
+	for i := range paths {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var parsed []*LinkedInProfile
+	var fileErrs []error
+	for i := range paths {
+		if errs[i] != nil {
+			fileErrs = append(fileErrs, errs[i])
+			continue
+		}
+		parsed = append(parsed, profiles[i])
+	}
+	return parsed, fileErrs
+}
+
+// parseProfileFile reads and parses one saved profile response.
+func parseProfileFile(path string) (*LinkedInProfile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := ParseFromJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return profile, nil
+}
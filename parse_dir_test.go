@@ -0,0 +1,38 @@
+package linkedinscraper_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("ParseProfilesFromDir", func() {
+	It("parses every JSON file and reports the malformed ones", func() {
+		dir := GinkgoT().TempDir()
+		write := func(name string, data []byte) {
+			Expect(os.WriteFile(filepath.Join(dir, name), data, 0o644)).To(Succeed())
+		}
+		write("a_profile.json", loadFixture("profile.json"))
+		write("b_research.json", loadFixture("profile_research.json"))
+		write("c_broken.json", []byte(`{"included": [`))
+		write("notes.txt", []byte("not a response"))
+
+		profiles, errs := linkedinscraper.ParseProfilesFromDir(dir)
+
+		Expect(profiles).To(HaveLen(2))
+		Expect(profiles[0].PublicIdentifier).To(Equal("jane-doe"))
+		Expect(profiles[1].Patents).NotTo(BeEmpty())
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Error()).To(ContainSubstring("c_broken.json"))
+	})
+
+	It("returns the error for a missing directory", func() {
+		profiles, errs := linkedinscraper.ParseProfilesFromDir(filepath.Join(GinkgoT().TempDir(), "missing"))
+		Expect(profiles).To(BeEmpty())
+		Expect(errs).To(HaveLen(1))
+	})
+})
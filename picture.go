@@ -0,0 +1,153 @@
+package linkedinscraper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// ProfilePictureUnchanged asks the media CDN whether the picture at pictureURL still has
+// the entity tag knownETag, without downloading it. It sends a conditional HEAD request
+// and reports true on a 304 Not Modified, or when the CDN ignores the condition but
+// answers with the same ETag. The returned ETag is the picture's current one, to store
+// for the next check. An empty knownETag always reports a change. Expired signed URLs
+// yield ErrPictureURLExpired.
+//
+// Picture URLs are public signed links, so the request carries no LinkedIn credentials.
+func (c *Client) ProfilePictureUnchanged(ctx context.Context, pictureURL string, knownETag string) (bool, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, pictureURL, nil)
+	if err != nil {
+		return false, "", fmt.Errorf("%w: %v", ErrRequestBuildFailed, err)
+	}
+	req.Header.Set("User-Agent", c.config.UserAgent)
+	if knownETag != "" {
+		req.Header.Set("If-None-Match", knownETag)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false, "", fmt.Errorf("%w: %s: %v", ErrRequestFailed, sanitizeURL(pictureURL), err)
+	}
+	resp.Body.Close()
+
+	etag := resp.Header.Get("ETag")
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		if etag == "" {
+			etag = knownETag
+		}
+		return true, etag, nil
+	case http.StatusOK:
+		return knownETag != "" && etag == knownETag, etag, nil
+	default:
+		return false, "", pictureStatusError(resp)
+	}
+}
+
+// FetchProfilePictures downloads the pictures of profiles in parallel, with at most
+// concurrency requests in flight (DefaultFetchPicturesConcurrency when not positive), and
+// returns the image bytes keyed by public identifier. Profiles without a picture URL or
+// whose signed URL has already expired are skipped without a request. Failures are per
+// picture: an expired URL, a missing image, or one larger than MaxProfilePictureBytes
+// leaves that profile out of the result and is logged at warn level, while the other
+// downloads carry on. Refetch the profile to get a fresh URL for a skipped picture.
+//
+// Picture URLs are public signed links, so the requests carry no LinkedIn credentials.
+func (c *Client) FetchProfilePictures(ctx context.Context, profiles []*LinkedInProfile, concurrency int) map[string][]byte {
+	if concurrency <= 0 {
+		concurrency = DefaultFetchPicturesConcurrency
+	}
+
+	now := c.now()
+	var pending []*LinkedInProfile
+	for _, profile := range profiles {
+		if profile == nil || profile.PublicIdentifier == "" || profile.ProfilePicture == nil || profile.ProfilePicture.URL == "" {
+			continue
+		}
+		if profile.ProfilePicture.IsURLExpired(now) {
+			c.logDebug("skipping expired profile picture URL", "publicIdentifier", profile.PublicIdentifier)
+			continue
+		}
+		pending = append(pending, profile)
+	}
+
+	pictures := make(map[string][]byte, len(pending))
+	var mu sync.Mutex
+	jobs := make(chan *LinkedInProfile)
+	var wg sync.WaitGroup
+	for range min(concurrency, len(pending)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for profile := range jobs {
+				data, err := c.fetchProfilePicture(ctx, profile.ProfilePicture.URL)
+				if err != nil {
+					c.logWarn("failed to fetch profile picture", "publicIdentifier", profile.PublicIdentifier, "error", err)
+					continue
+				}
+				mu.Lock()
+				pictures[profile.PublicIdentifier] = data
+				mu.Unlock()
+			}
+		}()
+	}
+dispatch:
+	for _, profile := range pending {
+		select {
+		case jobs <- profile:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return pictures
+}
+
+// errPictureTooLarge reports a picture body over MaxProfilePictureBytes.
+var errPictureTooLarge = errors.New("picture exceeds MaxProfilePictureBytes")
+
+// fetchProfilePicture downloads the image at pictureURL, refusing bodies larger than
+// MaxProfilePictureBytes.
+func (c *Client) fetchProfilePicture(ctx context.Context, pictureURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pictureURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRequestBuildFailed, err)
+	}
+	req.Header.Set("User-Agent", c.config.UserAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrRequestFailed, sanitizeURL(pictureURL), err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, pictureStatusError(resp)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, MaxProfilePictureBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrRequestFailed, sanitizeURL(pictureURL), err)
+	}
+	if len(data) > MaxProfilePictureBytes {
+		return nil, fmt.Errorf("%s: %w", sanitizeURL(pictureURL), errPictureTooLarge)
+	}
+	return data, nil
+}
+
+// pictureStatusError maps a failed media CDN response to the package's sentinel errors.
+// The CDN answers 403 or 410 once a signed URL's expiry has passed.
+func pictureStatusError(resp *http.Response) error {
+	switch resp.StatusCode {
+	case http.StatusForbidden, http.StatusGone:
+		return fmt.Errorf("%w: %s: status %d", ErrPictureURLExpired, responseURL(resp), resp.StatusCode)
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %s: status %d", ErrNotFound, responseURL(resp), resp.StatusCode)
+	default:
+		return fmt.Errorf("%w: %s: received status code %d", ErrRequestFailed, responseURL(resp), resp.StatusCode)
+	}
+}
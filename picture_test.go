@@ -0,0 +1,160 @@
+package linkedinscraper_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("ProfilePictureUnchanged", func() {
+	var (
+		cdn      *httptest.Server
+		requests []*http.Request
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		cdn = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r)
+			switch r.URL.Path {
+			case "/expired.jpg":
+				w.WriteHeader(http.StatusForbidden)
+			case "/avatar.jpg":
+				w.Header().Set("ETag", `"v2"`)
+				if r.Header.Get("If-None-Match") == `"v2"` {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				w.Header().Set("Content-Type", "image/jpeg")
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		DeferCleanup(cdn.Close)
+	})
+
+	It("reports an unchanged picture when the CDN answers 304", func() {
+		unchanged, etag, err := newTestClient(http.DefaultTransport).ProfilePictureUnchanged(context.Background(), cdn.URL+"/avatar.jpg", `"v2"`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unchanged).To(BeTrue())
+		Expect(etag).To(Equal(`"v2"`))
+
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Method).To(Equal(http.MethodHead))
+		Expect(requests[0].Header.Get("If-None-Match")).To(Equal(`"v2"`))
+		Expect(requests[0].Header.Get("Cookie")).To(BeEmpty())
+	})
+
+	It("returns the new ETag when the picture changed", func() {
+		unchanged, etag, err := newTestClient(http.DefaultTransport).ProfilePictureUnchanged(context.Background(), cdn.URL+"/avatar.jpg", `"v1"`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unchanged).To(BeFalse())
+		Expect(etag).To(Equal(`"v2"`))
+	})
+
+	It("reports a change when no ETag is known yet", func() {
+		unchanged, etag, err := newTestClient(http.DefaultTransport).ProfilePictureUnchanged(context.Background(), cdn.URL+"/avatar.jpg", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unchanged).To(BeFalse())
+		Expect(etag).To(Equal(`"v2"`))
+		Expect(requests[0].Header.Values("If-None-Match")).To(BeEmpty())
+	})
+
+	It("recognises an expired signed URL", func() {
+		_, _, err := newTestClient(http.DefaultTransport).ProfilePictureUnchanged(context.Background(), cdn.URL+"/expired.jpg", `"v2"`)
+		Expect(err).To(MatchError(linkedinscraper.ErrPictureURLExpired))
+	})
+})
+
+var _ = Describe("FetchProfilePictures", func() {
+	var (
+		cdn      *httptest.Server
+		mu       sync.Mutex
+		served   []string
+		inFlight atomic.Int32
+		peak     atomic.Int32
+	)
+
+	BeforeEach(func() {
+		served = nil
+		inFlight.Store(0)
+		peak.Store(0)
+		cdn = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				seen := peak.Load()
+				if current <= seen || peak.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			served = append(served, r.URL.Path)
+			mu.Unlock()
+			switch r.URL.Path {
+			case "/expired.jpg":
+				w.WriteHeader(http.StatusForbidden)
+			case "/huge.jpg":
+				w.Write(make([]byte, linkedinscraper.MaxProfilePictureBytes+1))
+			default:
+				w.Header().Set("Content-Type", "image/jpeg")
+				w.Write([]byte("jpeg:" + r.URL.Path))
+			}
+		}))
+		DeferCleanup(cdn.Close)
+	})
+
+	withPicture := func(publicIdentifier, path string) *linkedinscraper.LinkedInProfile {
+		profile := &linkedinscraper.LinkedInProfile{PublicIdentifier: publicIdentifier}
+		if path != "" {
+			profile.ProfilePicture = &linkedinscraper.ProfilePicture{URL: cdn.URL + path}
+		}
+		return profile
+	}
+
+	It("downloads every resolvable picture, keyed by public identifier", func() {
+		profiles := []*linkedinscraper.LinkedInProfile{
+			withPicture("alice", "/alice.jpg"),
+			withPicture("bob", "/bob.jpg"),
+			withPicture("carol", "/carol.jpg"),
+			withPicture("dave", "/dave.jpg"),
+			withPicture("no-picture", ""),
+			nil,
+		}
+
+		pictures := newTestClient(http.DefaultTransport).FetchProfilePictures(context.Background(), profiles, 2)
+		Expect(pictures).To(Equal(map[string][]byte{
+			"alice": []byte("jpeg:/alice.jpg"),
+			"bob":   []byte("jpeg:/bob.jpg"),
+			"carol": []byte("jpeg:/carol.jpg"),
+			"dave":  []byte("jpeg:/dave.jpg"),
+		}))
+		Expect(peak.Load()).To(BeNumerically("<=", 2))
+	})
+
+	It("leaves out pictures whose URL expired without failing the others", func() {
+		stale := withPicture("stale", "/stale.jpg")
+		stale.ProfilePicture.ExpiresAt = time.Now().Add(-time.Hour).UnixMilli()
+		profiles := []*linkedinscraper.LinkedInProfile{
+			withPicture("alice", "/alice.jpg"),
+			withPicture("revoked", "/expired.jpg"),
+			withPicture("huge", "/huge.jpg"),
+			stale,
+		}
+
+		pictures := newTestClient(http.DefaultTransport).FetchProfilePictures(context.Background(), profiles, 0)
+		Expect(pictures).To(HaveLen(1))
+		Expect(pictures).To(HaveKeyWithValue("alice", []byte("jpeg:/alice.jpg")))
+		Expect(served).NotTo(ContainElement("/stale.jpg"))
+	})
+})
@@ -0,0 +1,124 @@
+package linkedinscraper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GetProfilePosts fetches up to count recent posts shared by the member identified by
+// profileURN (e.g. "urn:li:fsd_profile:ACoAAA..."). Profiles without public posts
+// yield an empty slice and no error.
+func (c *Client) GetProfilePosts(ctx context.Context, profileURN string, count int) ([]Post, error) {
+	ctx = withOperation(ctx, "GetProfilePosts")
+
+	// Input Validation
+	if c.config.Auth.LiAtCookie == "" || c.config.Auth.CSRFToken == "" {
+		return nil, ErrAuthMissing
+	}
+	if profileURN == "" {
+		return nil, fmt.Errorf("profileURN cannot be empty")
+	}
+	if count <= 0 {
+		count = 20
+	}
+
+	// Build URL
+	requestURL, err := buildProfileUpdatesGraphQLURL(VoyagerBaseURL, DefaultProfileUpdatesQueryID, profileURN, count)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRequestBuildFailed, err)
+	}
+
+	// Prepare Headers
+	customHeaders := http.Header{}
+	customHeaders.Set("Accept", AcceptHeaderValue)
+	customHeaders.Set("X-Li-Pem-Metadata", "Voyager - Profile=recent-activity")
+
+	// Make API Call
+	resp, respBodyBytes, err := c.makeRequest(ctx, http.MethodGet, requestURL, customHeaders, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRequestFailed, err)
+	}
+
+	// Error Handling (HTTP Status)
+	if err := statusError(resp, respBodyBytes); err != nil {
+		return nil, err
+	}
+
+	// Parse JSON Response
+	var apiResponse ProfileUpdatesAPIResponse
+	if err := c.decodeResponse(respBodyBytes, &apiResponse); err != nil {
+		return nil, fmt.Errorf("%w: %v. Raw response: %s", ErrResponseParseFailed, err, string(respBodyBytes))
+	}
+
+	return parsePostsFromAPIResponse(&apiResponse), nil
+}
+
+// buildProfileUpdatesGraphQLURL constructs the URL for a profile updates request.
+func buildProfileUpdatesGraphQLURL(baseURL, queryID, profileURN string, count int) (string, error) {
+	parsedBaseURL, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse base URL: %w", err)
+	}
+
+	variablesString := fmt.Sprintf("(count:%d,start:0,profileUrn:%s)", count, escapeRestliString(profileURN))
+
+	query := parsedBaseURL.Query()
+	query.Set("queryId", queryID)
+	query.Set("includeWebMetadata", "true")
+
+	// Append the variables part with literal parentheses, as for the other queries
+	parsedBaseURL.RawQuery = query.Encode() + "&variables=" + variablesString
+
+	return parsedBaseURL.String(), nil
+}
+
+// parsePostsFromAPIResponse builds Posts from Update entities, joining their social
+// activity counts by activity URN.
+func parsePostsFromAPIResponse(apiResponse *ProfileUpdatesAPIResponse) []Post {
+	counts := make(map[string]FeedIncludedElement)
+	for _, item := range apiResponse.Included {
+		if item.Type == EntityTypeSocialActivityCounts && item.URN != "" {
+			counts[item.URN] = item
+		}
+	}
+
+	posts := []Post{}
+	for _, item := range apiResponse.Included {
+		if item.Type != EntityTypeUpdate || item.Metadata == nil || item.Metadata.BackendURN == "" {
+			continue
+		}
+
+		post := Post{
+			URN:      item.Metadata.BackendURN,
+			PostedAt: activityTime(item.Metadata.BackendURN),
+		}
+		if item.Commentary != nil {
+			post.Text = string(item.Commentary.Text)
+		}
+		if item.SocialContent != nil {
+			post.URL = item.SocialContent.ShareURL
+		}
+		if count, ok := counts[post.URN]; ok {
+			post.LikeCount = int(count.NumLikes)
+			post.CommentCount = int(count.NumComments)
+		}
+		posts = append(posts, post)
+	}
+	return posts
+}
+
+// activityTime decodes the creation time embedded in an activity URN. LinkedIn activity
+// IDs carry a millisecond timestamp in their upper 41 bits. It returns the zero time
+// when the URN does not end in a numeric ID.
+func activityTime(activityURN string) time.Time {
+	id, err := strconv.ParseUint(activityURN[strings.LastIndex(activityURN, ":")+1:], 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(int64(id >> 22)).UTC()
+}
@@ -0,0 +1,39 @@
+package linkedinscraper_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetProfilePosts", func() {
+	const profileURN = "urn:li:fsd_profile:ACoAAAJaneDoe"
+
+	It("parses a two-post response", func() {
+		transport := newFakeTransport(http.StatusOK, loadFixture("profile_posts.json"))
+		posts, err := newTestClient(transport).GetProfilePosts(context.Background(), profileURN, 2)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(transport.Requests()[0].URL.RawQuery).To(ContainSubstring("profileUrn:urn%3Ali%3Afsd_profile%3AACoAAAJaneDoe"))
+
+		Expect(posts).To(HaveLen(2))
+		Expect(posts[0].URN).To(Equal("urn:li:activity:7191405998899212345"))
+		Expect(posts[0].Text).To(Equal("Excited to announce our new fund!"))
+		Expect(posts[0].PostedAt).To(Equal(time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)))
+		Expect(posts[0].LikeCount).To(Equal(120))
+		Expect(posts[0].CommentCount).To(Equal(14))
+		Expect(posts[0].URL).To(HavePrefix("https://www.linkedin.com/posts/jane-doe_new-fund"))
+		Expect(posts[1].Text).To(Equal("Thoughts on seed valuations."))
+		Expect(posts[1].LikeCount).To(Equal(8))
+	})
+
+	It("returns an empty slice for a profile without posts", func() {
+		body := []byte(`{"data":{"data":{"feedDashProfileUpdatesByMemberShareFeed":{"*elements":[]}}},"included":[]}`)
+		posts, err := newTestClient(newFakeTransport(http.StatusOK, body)).GetProfilePosts(context.Background(), profileURN, 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(posts).To(BeEmpty())
+	})
+})
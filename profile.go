@@ -3,11 +3,25 @@ package linkedinscraper
 import (
 	"encoding/json"
 	"fmt"
+	"html"
+	"regexp"
+	"slices"
+	"sort"
 	"strings"
+	"unicode"
 )
 
 // parseProfileFromAPIResponse parses a ProfileAPIResponse and extracts comprehensive profile data.
-func parseProfileFromAPIResponse(apiResponse *ProfileAPIResponse, publicIdentifier string) (*LinkedInProfile, error) {
+func parseProfileFromAPIResponse(apiResponse *ProfileAPIResponse, publicIdentifier string, opts parseOptions) (*LinkedInProfile, error) {
+	profileEntity, err := findProfileEntity(apiResponse, publicIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	return parseProfileEntity(newIncludedIndex(apiResponse.Included), profileEntity, opts)
+}
+
+// findProfileEntity locates the profile entity for publicIdentifier in the included array.
+func findProfileEntity(apiResponse *ProfileAPIResponse, publicIdentifier string) (*GenericIncludedElement, error) {
 	// Find the main profile entity in the included array
 	var profileEntity *GenericIncludedElement
 
@@ -20,186 +34,715 @@ func parseProfileFromAPIResponse(apiResponse *ProfileAPIResponse, publicIdentifi
 	}
 
 	if profileEntity == nil {
-		return nil, fmt.Errorf("profile not found in API response for publicIdentifier: %s", publicIdentifier)
+		if isRestrictedProfileResponse(apiResponse) {
+			return nil, fmt.Errorf("%w: %s", ErrProfileRestricted, publicIdentifier)
+		}
+		if len(apiResponse.Included) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrEmptyResponse, publicIdentifier)
+		}
+		// Some responses omit publicIdentifier on the entity and identify it by URN only;
+		// the profile was looked up by publicIdentifier, so adopt the requested one.
+		fallback := primaryProfileEntity(apiResponse)
+		if fallback == nil || fallback.PublicIdentifier != "" {
+			return nil, fmt.Errorf("profile not found in API response for publicIdentifier: %s", publicIdentifier)
+		}
+		entity := *fallback
+		entity.PublicIdentifier = publicIdentifier
+		profileEntity = &entity
+	}
+
+	return profileEntity, nil
+}
+
+// primaryProfileEntity returns the profile entity referenced by the collection elements,
+// or the first profile entity when none is referenced, or nil.
+func primaryProfileEntity(apiResponse *ProfileAPIResponse) *GenericIncludedElement {
+	var first *GenericIncludedElement
+	for i, item := range apiResponse.Included {
+		if item.Type != EntityTypeProfile {
+			continue
+		}
+		for _, urn := range apiResponse.Data.Data.IdentityDashProfilesByMemberIdentity.Elements {
+			if item.EntityURN == urn {
+				return &apiResponse.Included[i]
+			}
+		}
+		if first == nil {
+			first = &apiResponse.Included[i]
+		}
+	}
+	return first
+}
+
+// isRestrictedProfileResponse reports whether the response acknowledges a profile (its URN
+// is listed in the collection elements) while withholding the profile entity itself, which
+// is how LinkedIn answers for out-of-network or restricted profiles.
+func isRestrictedProfileResponse(apiResponse *ProfileAPIResponse) bool {
+	elements := apiResponse.Data.Data.IdentityDashProfilesByMemberIdentity.Elements
+	if len(elements) == 0 {
+		return false
+	}
+	for _, item := range apiResponse.Included {
+		if item.Type == EntityTypeProfile {
+			for _, urn := range elements {
+				if item.EntityURN == urn {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// privateMemberNames are the lowercase placeholders LinkedIn shows, per locale, instead of
+// the name of an out-of-network member in private mode.
+var privateMemberNames = []string{
+	"linkedin member",
+	"linkedin-mitglied",
+	"membre de linkedin",
+	"miembro de linkedin",
+	"membro do linkedin",
+	"membro di linkedin",
+	"linkedin-lid",
+}
+
+// isPrivateMemberName reports whether name is a private-mode placeholder.
+func isPrivateMemberName(name string) bool {
+	name = strings.ToLower(strings.Join(strings.Fields(name), " "))
+	for _, placeholder := range privateMemberNames {
+		if name == placeholder {
+			return true
+		}
+	}
+	return false
+}
+
+// markPrivateMember sets IsPrivate on a profile that carries the private-mode placeholder
+// name, which is then cleared, or that has neither a public identifier, also when read
+// from an /in/ profile URL, nor a headline. Localized placeholders may be split at their
+// hyphen, e.g. "LinkedIn-" and "Mitglied", so the name parts are also checked unspaced.
+func markPrivateMember(profile *LinkedInProfile) {
+	if isPrivateMemberName(profile.FullName) ||
+		isPrivateMemberName(profile.FirstName+" "+profile.LastName) ||
+		isPrivateMemberName(profile.FirstName+profile.LastName) {
+		profile.FullName, profile.FirstName, profile.LastName = "", "", ""
+		profile.IsPrivate = true
+		return
+	}
+	hasPublicIdentifier := profile.PublicIdentifier != "" ||
+		(strings.Contains(profile.ProfileURL, "/in/") && NormalizePublicIdentifier(profile.ProfileURL) != "")
+	if !hasPublicIdentifier && profile.Headline == "" {
+		profile.IsPrivate = true
+	}
+}
+
+// includedIndex groups a response's included entities by $type so each section parser
+// reads only its own entities instead of rescanning the whole array. Entities keep
+// their original response order within (and, via positions, across) types.
+type includedIndex struct {
+	included []GenericIncludedElement
+	byType   map[string][]int
+}
+
+// newIncludedIndex indexes included in a single pass.
+func newIncludedIndex(included []GenericIncludedElement) *includedIndex {
+	idx := &includedIndex{included: included, byType: make(map[string][]int)}
+	for i := range included {
+		idx.byType[included[i].Type] = append(idx.byType[included[i].Type], i)
+	}
+	return idx
+}
+
+// ofType returns the entities whose $type is exactly entityType, in response order.
+func (idx *includedIndex) ofType(entityType string) []*GenericIncludedElement {
+	positions := idx.byType[entityType]
+	items := make([]*GenericIncludedElement, len(positions))
+	for i, pos := range positions {
+		items[i] = &idx.included[pos]
+	}
+	return items
+}
+
+// containingType returns the entities whose $type contains substr, in response order.
+// It only scans the distinct types, which are few compared to the entities.
+func (idx *includedIndex) containingType(substr string) []*GenericIncludedElement {
+	var positions []int
+	for entityType, typePositions := range idx.byType {
+		if strings.Contains(entityType, substr) {
+			positions = append(positions, typePositions...)
+		}
+	}
+	sort.Ints(positions)
+	items := make([]*GenericIncludedElement, len(positions))
+	for i, pos := range positions {
+		items[i] = &idx.included[pos]
+	}
+	return items
+}
+
+// profileByURN returns the profile entity with the given URN, or nil.
+func (idx *includedIndex) profileByURN(urn string) *GenericIncludedElement {
+	for _, item := range idx.ofType(EntityTypeProfile) {
+		if item.EntityURN == urn {
+			return item
+		}
+	}
+	return nil
+}
+
+// profileEntityTypePrefix is the namespace of the entity types that make up a profile.
+const profileEntityTypePrefix = "com.linkedin.voyager.dash.identity.profile."
+
+// knownProfileEntityType reports whether a section parser handles entityType.
+func knownProfileEntityType(entityType string) bool {
+	switch entityType {
+	case EntityTypeProfile, EntityTypePosition, EntityTypeEducation, EntityTypeCertification,
+		EntityTypePatent, EntityTypePublication, EntityTypeInterest, EntityTypeTopSkills:
+		return true
+	}
+	return strings.Contains(entityType, EntityTypeEndorsedSkill) ||
+		strings.Contains(entityType, EntityTypeConnection) ||
+		strings.Contains(entityType, EntityTypeFollowing)
+}
+
+// unknownProfileTypes returns, sorted, the profile-namespace entity types no parser handles.
+// Types outside the namespace (collections, decorations, geo, ...) are not considered.
+func (idx *includedIndex) unknownProfileTypes() []string {
+	var unknown []string
+	for entityType := range idx.byType {
+		if strings.HasPrefix(entityType, profileEntityTypePrefix) && !knownProfileEntityType(entityType) {
+			unknown = append(unknown, entityType)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// parseProfileEntity builds a LinkedInProfile from the main profile entity and its related entities.
+// With opts.strictUnknownTypes it fails with ErrUnknownEntityType when idx holds profile
+// entity types no parser handles.
+func parseProfileEntity(idx *includedIndex, profileEntity *GenericIncludedElement, opts parseOptions) (*LinkedInProfile, error) {
+	if opts.strictUnknownTypes {
+		if unknown := idx.unknownProfileTypes(); len(unknown) > 0 {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownEntityType, strings.Join(unknown, ", "))
+		}
 	}
 
 	// Start building the LinkedInProfile
 	profile := &LinkedInProfile{
-		PublicIdentifier: profileEntity.PublicIdentifier,
-		URN:              profileEntity.EntityURN,
-		FirstName:        profileEntity.FirstName,
-		LastName:         profileEntity.LastName,
-		Headline:         profileEntity.Headline,
-		ProfileURL:       fmt.Sprintf("https://www.linkedin.com/in/%s/", publicIdentifier),
+		PublicIdentifier:    profileEntity.PublicIdentifier,
+		URN:                 profileEntity.EntityURN,
+		FirstName:           profileEntity.FirstName,
+		LastName:            profileEntity.LastName,
+		Headline:            profileEntity.Headline,
+		Summary:             profileEntity.Summary,
+		IndustryURN:         profileEntity.IndustryURN,
+		MultiLocaleHeadline: profileEntity.MultiLocaleHeadline,
+		Occupation:          profileEntity.Occupation,
+		ProfileURL:          fmt.Sprintf("https://www.linkedin.com/in/%s/", profileEntity.PublicIdentifier),
 	}
 
-	// Set FullName
-	profile.FullName = strings.TrimSpace(profile.FirstName + " " + profile.LastName)
+	// Set FullName, in the requested language variant's name order when it has its own names
+	nameLocale := profileEntity.PrimaryLocale
+	if opts.locale != "" && localizeProfile(profile, profileEntity, opts.locale) {
+		nameLocale = localeResponse(opts.locale)
+	}
+	profile.FullName = assembleFullName(profile.FirstName, profile.LastName, resolveNameFormat(opts.nameFormat, nameLocale))
+	profile.FetchedAt = opts.fetchedAtPtr()
 
 	// Parse additional profile data by finding and processing related entities
-	profile.Experience = parseExperienceData(apiResponse, profileEntity.EntityURN)
-	profile.Education = parseEducationData(apiResponse, profileEntity.EntityURN)
-	profile.Skills = parseSkillsData(apiResponse, profileEntity.EntityURN)
-	profile.LocationDetails = parseLocationData(apiResponse, profileEntity.EntityURN)
-	profile.ConnectionInfo = parseConnectionData(apiResponse, profileEntity.EntityURN)
-	profile.ProfilePicture = parseProfilePictureData(apiResponse, profileEntity.EntityURN)
+	profile.Experience = parseExperienceData(idx, profileEntity.EntityURN)
+	profile.Education = parseEducationData(idx, profileEntity.EntityURN)
+	profile.Skills = parseSkillsData(idx, profileEntity.EntityURN)
+	profile.TopSkills = parseTopSkillsData(idx, profileEntity.EntityURN)
+	profile.Certifications = parseCertificationsData(idx, profileEntity.EntityURN)
+	profile.Patents = parsePatentsData(idx, profileEntity.EntityURN)
+	profile.Publications = parsePublicationsData(idx, profileEntity.EntityURN)
+	profile.Services = parseServicesData(idx, profileEntity.EntityURN)
+	profile.Interests = parseInterestsData(idx, profileEntity.EntityURN)
+	profile.DisplayLocation = parseDisplayLocation(idx, profileEntity)
+	profile.Location = profile.DisplayLocation
+	profile.LocationDetails = parseLocationData(idx, profileEntity.EntityURN)
+	profile.ConnectionInfo = parseConnectionData(idx, profileEntity.EntityURN)
+	profile.ProfilePicture = parseProfilePictureData(idx, profileEntity.EntityURN)
+	profile.NetworkDistance, profile.MutualConnectionsCount = parseRelationshipData(idx, profileEntity.EntityURN)
+
+	if opts.cleanDescriptions {
+		cleanProfileDescriptions(profile)
+	}
+
+	// Order sections deterministically, newest first, before applying the entry limits
+	sortProfileSections(profile)
+	profile.CurrentCompany = profile.currentCompany()
+	if opts.maxExperience > 0 && len(profile.Experience) > opts.maxExperience {
+		profile.Experience = profile.Experience[:opts.maxExperience]
+	}
+	if opts.maxEducation > 0 && len(profile.Education) > opts.maxEducation {
+		profile.Education = profile.Education[:opts.maxEducation]
+	}
 
 	// Parse simple fields from the profile entity itself
-	parseSimpleProfileFields(profile, apiResponse, profileEntity)
+	parseSimpleProfileFields(profile, profileEntity)
+	markPrivateMember(profile)
 
 	return profile, nil
 }
 
+// sortProfileSections orders the parsed sections so repeated parses of a response give
+// identical results: dated entries newest first (see moreRecent) and skills by endorsement
+// count, then name. Ties keep the response order.
+func sortProfileSections(profile *LinkedInProfile) {
+	sort.SliceStable(profile.Experience, func(i, j int) bool {
+		return moreRecent(profile.Experience[i].DateRange, profile.Experience[j].DateRange)
+	})
+	sort.SliceStable(profile.Education, func(i, j int) bool {
+		return moreRecent(profile.Education[i].DateRange, profile.Education[j].DateRange)
+	})
+	sort.SliceStable(profile.Certifications, func(i, j int) bool {
+		return moreRecent(profile.Certifications[i].DateRange, profile.Certifications[j].DateRange)
+	})
+	sort.SliceStable(profile.Patents, func(i, j int) bool {
+		return moreRecent(dayRange(profile.Patents[i].Date), dayRange(profile.Patents[j].Date))
+	})
+	sort.SliceStable(profile.Publications, func(i, j int) bool {
+		return moreRecent(dayRange(profile.Publications[i].Date), dayRange(profile.Publications[j].Date))
+	})
+	sort.SliceStable(profile.Skills, func(i, j int) bool {
+		a, b := profile.Skills[i], profile.Skills[j]
+		if a.EndorsementCount != b.EndorsementCount {
+			return a.EndorsementCount > b.EndorsementCount
+		}
+		return a.Name < b.Name
+	})
+}
+
+// descriptionLineBreak matches HTML line and paragraph breaks.
+var descriptionLineBreak = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</li>`)
+
+// descriptionTag matches any remaining HTML tag.
+var descriptionTag = regexp.MustCompile(`<[^>]*>`)
+
+// descriptionAsteriskEmphasis and descriptionUnderscoreEmphasis match markdown bold spans
+// within a line. As in CommonMark, underscores only delimit emphasis at word boundaries,
+// so identifiers such as snake__case are kept.
+var (
+	descriptionAsteriskEmphasis   = regexp.MustCompile(`\*\*(\S|\S[^\n]*?\S)\*\*`)
+	descriptionUnderscoreEmphasis = regexp.MustCompile(`\b__(\S|\S[^\n]*?\S)__\b`)
+)
+
+// descriptionEscapeReplacer undoes LinkedIn's double-escaping of a description, which
+// leaves literal escape sequences in place of its line breaks and tabs.
+var descriptionEscapeReplacer = strings.NewReplacer(`\r\n`, "\n", `\n`, "\n", `\t`, " ")
+
+// descriptionReplacer normalizes line endings and tabs and removes invisible characters.
+var descriptionReplacer = strings.NewReplacer(
+	"\r\n", "\n", "\r", "\n", "\t", " ",
+	"\u00a0", " ", "\u200b", "", "\u200c", "", "\u200d", "", "\ufeff", "",
+)
+
+// cleanDescription strips formatting from a description, collapses runs of spaces, trims
+// each line and allows at most one blank line between paragraphs. Literal escape sequences
+// are only unescaped in a description without real line breaks, i.e. one that was escaped
+// twice, so text such as a C:\new path in a multi-line description is kept.
+func cleanDescription(description string) string {
+	doubleEscaped := !strings.ContainsAny(description, "\r\n")
+	text := descriptionLineBreak.ReplaceAllString(description, "\n")
+	text = descriptionTag.ReplaceAllString(text, "")
+	text = html.UnescapeString(text)
+	if doubleEscaped {
+		text = descriptionEscapeReplacer.Replace(text)
+	}
+	text = descriptionAsteriskEmphasis.ReplaceAllString(text, "$1")
+	text = descriptionUnderscoreEmphasis.ReplaceAllString(text, "$1")
+	text = descriptionReplacer.Replace(text)
+
+	var lines []string
+	blank := false
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.Join(strings.Fields(line), " ")
+		if line == "" {
+			blank = len(lines) > 0
+			continue
+		}
+		if blank {
+			lines = append(lines, "")
+			blank = false
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// cleanProfileDescriptions applies cleanDescription to every description on profile.
+func cleanProfileDescriptions(profile *LinkedInProfile) {
+	for i := range profile.Experience {
+		profile.Experience[i].Description = cleanDescription(profile.Experience[i].Description)
+	}
+	for i := range profile.Education {
+		profile.Education[i].Description = cleanDescription(profile.Education[i].Description)
+	}
+	for i := range profile.Publications {
+		profile.Publications[i].Description = cleanDescription(profile.Publications[i].Description)
+	}
+}
+
+// dayRange wraps a single date as a range starting and ending on it.
+func dayRange(date *Date) *DateRange {
+	if date == nil {
+		return nil
+	}
+	return &DateRange{Start: date, End: date}
+}
+
+// parseProfileTopCard builds a LinkedInProfile from the top-card fields only: identity,
+// name, headline, location, picture and current company. Sections are left empty.
+func parseProfileTopCard(idx *includedIndex, profileEntity *GenericIncludedElement, opts parseOptions) *LinkedInProfile {
+	profile := &LinkedInProfile{
+		PublicIdentifier:    profileEntity.PublicIdentifier,
+		URN:                 profileEntity.EntityURN,
+		FirstName:           profileEntity.FirstName,
+		LastName:            profileEntity.LastName,
+		Headline:            profileEntity.Headline,
+		MultiLocaleHeadline: profileEntity.MultiLocaleHeadline,
+		Occupation:          profileEntity.Occupation,
+		ProfileURL:          fmt.Sprintf("https://www.linkedin.com/in/%s/", profileEntity.PublicIdentifier),
+	}
+	profile.FullName = assembleFullName(profile.FirstName, profile.LastName, resolveNameFormat(opts.nameFormat, profileEntity.PrimaryLocale))
+	profile.FetchedAt = opts.fetchedAtPtr()
+	profile.DisplayLocation = parseDisplayLocation(idx, profileEntity)
+	profile.Location = profile.DisplayLocation
+	profile.ProfilePicture = parseProfilePictureData(idx, profileEntity.EntityURN)
+
+	positions := &LinkedInProfile{Experience: parseExperienceData(idx, profileEntity.EntityURN)}
+	sortProfileSections(positions)
+	profile.CurrentCompany = positions.currentCompany()
+	markPrivateMember(profile)
+	return profile
+}
+
 // parseExperienceData extracts experience/position data from the API response.
-func parseExperienceData(apiResponse *ProfileAPIResponse, profileURN string) []Experience {
+func parseExperienceData(idx *includedIndex, profileURN string) []Experience {
 	var experiences []Experience
-	for _, item := range apiResponse.Included {
-		if item.Type == EntityTypePosition {
-			experience := Experience{
-				EntityURN:    item.EntityURN,
-				CompanyName:  item.CompanyName,
-				Description:  item.Description,
-				LocationName: item.LocationName,
-				CompanyURN:   item.CompanyURN,
-			}
-			if item.Title != nil {
-				experience.Title = string(*item.Title)
-			}
-			if item.DateRange != nil {
-				experience.DateRange = &DateRange{}
-				if item.DateRange.Start != nil {
-					experience.DateRange.Start = &Date{
-						Year:  item.DateRange.Start.Year,
-						Month: item.DateRange.Start.Month,
-						Day:   item.DateRange.Start.Day,
-					}
+	for _, item := range idx.ofType(EntityTypePosition) {
+		experience := Experience{
+			EntityURN:    item.EntityURN,
+			CompanyName:  item.CompanyName,
+			Description:  item.Description,
+			LocationName: item.LocationName,
+			CompanyURN:   item.CompanyURN,
+		}
+		experience.Title = string(item.Title)
+		if item.DateRange != nil {
+			experience.DateRange = &DateRange{}
+			if item.DateRange.Start != nil {
+				experience.DateRange.Start = &Date{
+					Year:  item.DateRange.Start.Year,
+					Month: item.DateRange.Start.Month,
+					Day:   item.DateRange.Start.Day,
 				}
-				if item.DateRange.End != nil {
-					experience.DateRange.End = &Date{
-						Year:  item.DateRange.End.Year,
-						Month: item.DateRange.End.Month,
-						Day:   item.DateRange.End.Day,
-					}
+			}
+			if item.DateRange.End != nil {
+				experience.DateRange.End = &Date{
+					Year:  item.DateRange.End.Year,
+					Month: item.DateRange.End.Month,
+					Day:   item.DateRange.End.Day,
 				}
 			}
-			experiences = append(experiences, experience)
 		}
+		experiences = append(experiences, experience)
 	}
 	return experiences
 }
 
 // parseEducationData extracts education data from the API response.
-func parseEducationData(apiResponse *ProfileAPIResponse, profileURN string) []Education {
+func parseEducationData(idx *includedIndex, profileURN string) []Education {
 	var education []Education
-	for _, item := range apiResponse.Included {
-		if item.Type == EntityTypeEducation {
-			edu := Education{
-				EntityURN:    item.EntityURN,
-				SchoolName:   item.SchoolName,
-				SchoolURN:    item.SchoolURN,
-				DegreeName:   item.DegreeName,
-				FieldOfStudy: item.FieldOfStudy,
-				Description:  item.Description,
-				Activities:   item.Activities,
-			}
-			if item.DateRange != nil {
-				edu.DateRange = &DateRange{}
-				if item.DateRange.Start != nil {
-					edu.DateRange.Start = &Date{
-						Year:  item.DateRange.Start.Year,
-						Month: item.DateRange.Start.Month,
-						Day:   item.DateRange.Start.Day,
-					}
+	for _, item := range idx.ofType(EntityTypeEducation) {
+		edu := Education{
+			EntityURN:    item.EntityURN,
+			SchoolName:   item.SchoolName,
+			SchoolURN:    item.SchoolURN,
+			DegreeName:   item.DegreeName,
+			FieldOfStudy: item.FieldOfStudy,
+			Description:  item.Description,
+			Activities:   item.Activities,
+			Grade:        item.Grade,
+		}
+		if item.DateRange != nil {
+			edu.DateRange = &DateRange{}
+			if item.DateRange.Start != nil {
+				edu.DateRange.Start = &Date{
+					Year:  item.DateRange.Start.Year,
+					Month: item.DateRange.Start.Month,
+					Day:   item.DateRange.Start.Day,
 				}
-				if item.DateRange.End != nil {
-					edu.DateRange.End = &Date{
-						Year:  item.DateRange.End.Year,
-						Month: item.DateRange.End.Month,
-						Day:   item.DateRange.End.Day,
-					}
+			}
+			if item.DateRange.End != nil {
+				edu.DateRange.End = &Date{
+					Year:  item.DateRange.End.Year,
+					Month: item.DateRange.End.Month,
+					Day:   item.DateRange.End.Day,
 				}
 			}
-			education = append(education, edu)
 		}
+		education = append(education, edu)
 	}
 	return education
 }
 
 // parseSkillsData extracts skills data from the API response.
-func parseSkillsData(apiResponse *ProfileAPIResponse, profileURN string) []Skill {
+func parseSkillsData(idx *includedIndex, profileURN string) []Skill {
 	var skills []Skill
-	for _, item := range apiResponse.Included {
-		if strings.Contains(item.Type, EntityTypeEndorsedSkill) { // The type can vary slightly
-			skill := Skill{
-				EntityURN:        item.EntityURN,
-				Name:             item.Name,
-				EndorsementCount: item.EndorsementCount,
-				EndorsedByViewer: item.EndorsedByViewer,
-			}
-			skills = append(skills, skill)
+	for _, item := range idx.containingType(EntityTypeEndorsedSkill) { // The type can vary slightly
+		skill := Skill{
+			EntityURN:        item.EntityURN,
+			Name:             item.Name,
+			EndorsementCount: int(item.EndorsementCount),
+			EndorsedByViewer: item.EndorsedByViewer,
 		}
+		skills = append(skills, skill)
 	}
 	return skills
 }
 
+// parseTopSkillsData extracts the skill names shown on the top card, in display order.
+// They come from their own entity, keyed by the same profile ID as profileURN, and need
+// not appear in the full skills section.
+func parseTopSkillsData(idx *includedIndex, profileURN string) []string {
+	profileID := profileURN[strings.LastIndex(profileURN, ":")+1:]
+	var topSkills []string
+	for _, item := range idx.ofType(EntityTypeTopSkills) {
+		if item.EntityURN[strings.LastIndex(item.EntityURN, ":")+1:] != profileID {
+			continue // Top skills of another member, e.g. from "people also viewed"
+		}
+		for _, skill := range item.TopSkills {
+			if name := strings.TrimSpace(skill.Name); name != "" {
+				topSkills = append(topSkills, name)
+			}
+		}
+	}
+	return topSkills
+}
+
+// parseCertificationsData extracts license and certification data from the API response.
+func parseCertificationsData(idx *includedIndex, profileURN string) []Certification {
+	var certifications []Certification
+	for _, item := range idx.ofType(EntityTypeCertification) {
+		certification := Certification{
+			EntityURN:     item.EntityURN,
+			Name:          item.Name,
+			Authority:     item.Authority,
+			LicenseNumber: item.LicenseNumber,
+			URL:           item.URL,
+		}
+		if item.DateRange != nil {
+			certification.DateRange = &DateRange{
+				Start: dateFromResponse(item.DateRange.Start),
+				End:   dateFromResponse(item.DateRange.End),
+			}
+		}
+		certifications = append(certifications, certification)
+	}
+	return certifications
+}
+
+// parsePatentsData extracts patent data from the API response.
+func parsePatentsData(idx *includedIndex, profileURN string) []Patent {
+	var patents []Patent
+	for _, item := range idx.ofType(EntityTypePatent) {
+		patent := Patent{
+			EntityURN: item.EntityURN,
+			Number:    item.Number,
+			Issuer:    item.Issuer,
+			Date:      dateFromResponse(item.IssuedOn),
+			URL:       item.URL,
+		}
+		patent.Title = string(item.Title)
+		patents = append(patents, patent)
+	}
+	return patents
+}
+
+// parsePublicationsData extracts publication data from the API response.
+func parsePublicationsData(idx *includedIndex, profileURN string) []Publication {
+	var publications []Publication
+	for _, item := range idx.ofType(EntityTypePublication) {
+		publications = append(publications, Publication{
+			EntityURN:   item.EntityURN,
+			Title:       item.Name,
+			Publisher:   item.Publisher,
+			Date:        dateFromResponse(item.PublishedOn),
+			URL:         item.URL,
+			Description: item.Description,
+		})
+	}
+	return publications
+}
+
+// parseDisplayLocation returns the human-readable top-card location, resolving the profile's
+// geoLocation reference and falling back to its plain locationName text.
+func parseDisplayLocation(idx *includedIndex, profileEntity *GenericIncludedElement) string {
+	if profileEntity.GeoLocation != nil && profileEntity.GeoLocation.GeoURN != "" {
+		for _, item := range idx.ofType(EntityTypeGeo) {
+			if item.EntityURN == profileEntity.GeoLocation.GeoURN && item.DefaultLocalizedName != "" {
+				return item.DefaultLocalizedName
+			}
+		}
+	}
+	return profileEntity.LocationName
+}
+
 // parseLocationData extracts location information from the API response.
-func parseLocationData(apiResponse *ProfileAPIResponse, profileURN string) *ProfileLocation {
+func parseLocationData(idx *includedIndex, profileURN string) *ProfileLocation {
 	// Look for location data in the main profile entity or related entities
-	for _, item := range apiResponse.Included {
-		if item.Type == EntityTypeProfile &&
-			item.EntityURN == profileURN {
-			// Parse location from the profile entity
-			// This would need to be adjusted based on actual API structure
-			return &ProfileLocation{
-				CountryCode: extractCountryCode(item),
-			}
+	if item := idx.profileByURN(profileURN); item != nil {
+		// Parse location from the profile entity
+		// This would need to be adjusted based on actual API structure
+		return &ProfileLocation{
+			CountryCode: extractCountryCode(*item),
 		}
 	}
 
 	return nil
 }
 
+// parseServicesData extracts the names of the services a member offers, nil when the
+// profile has no services page.
+func parseServicesData(idx *includedIndex, profileURN string) []string {
+	var services []string
+	for _, item := range idx.ofType(EntityTypeServiceProvider) {
+		for _, category := range item.ServiceCategories {
+			if category.Name != "" {
+				services = append(services, category.Name)
+			}
+		}
+	}
+	return services
+}
+
+// parseInterestsData sorts the followed companies, influencers, hashtags and schools of the
+// Interests section by interest type. It returns nil when the section is absent.
+func parseInterestsData(idx *includedIndex, profileURN string) *Interests {
+	var interests Interests
+	found := false
+	for _, item := range idx.ofType(EntityTypeInterest) {
+		if item.Name == "" {
+			continue
+		}
+		switch strings.ToUpper(item.InterestType) {
+		case "COMPANY":
+			interests.Companies = append(interests.Companies, item.Name)
+		case "INFLUENCER":
+			interests.Influencers = append(interests.Influencers, item.Name)
+		case "HASHTAG":
+			interests.Hashtags = append(interests.Hashtags, strings.TrimPrefix(item.Name, "#"))
+		case "SCHOOL":
+			interests.Schools = append(interests.Schools, item.Name)
+		default:
+			continue
+		}
+		found = true
+	}
+	if !found {
+		return nil
+	}
+	return &interests
+}
+
 // parseConnectionData extracts connection and following information.
-func parseConnectionData(apiResponse *ProfileAPIResponse, profileURN string) *ConnectionInfo {
+func parseConnectionData(idx *includedIndex, profileURN string) *ConnectionInfo {
 	connectionInfo := &ConnectionInfo{}
 
-	for _, item := range apiResponse.Included {
-		if strings.Contains(item.Type, EntityTypeConnection) {
-			// Parse connection count from the item
-			// This would need adjustment based on actual API structure
-			if count, err := parseConnectionCount(item); err == nil {
-				connectionInfo.ConnectionCount = count
-			}
+	for _, item := range idx.containingType(EntityTypeConnection) {
+		// Parse connection count from the item
+		// This would need adjustment based on actual API structure
+		if count, err := parseConnectionCount(*item); err == nil {
+			connectionInfo.ConnectionCount = count
 		}
-		if strings.Contains(item.Type, EntityTypeFollowing) {
-			// Parse follower/following information
-			// This would need adjustment based on actual API structure
-			if count, err := parseFollowerCount(item); err == nil {
-				connectionInfo.FollowerCount = count
-			}
+	}
+	for _, item := range idx.containingType(EntityTypeFollowing) {
+		// Parse follower/following information
+		// This would need adjustment based on actual API structure
+		if count, err := parseFollowerCount(*item); err == nil {
+			connectionInfo.FollowerCount = count
 		}
 	}
 
 	return connectionInfo
 }
 
+// parseRelationshipData returns the viewer's connection degree and mutual connection count
+// from the member relationship entity keyed by the same profile ID as profileURN
+// (e.g. "urn:li:fsd_memberRelationship:ACoAAAJaneDoe").
+func parseRelationshipData(idx *includedIndex, profileURN string) (NetworkFilter, int) {
+	profileID := profileURN[strings.LastIndex(profileURN, ":")+1:]
+	for _, item := range idx.ofType(EntityTypeMemberRelationship) {
+		if !strings.HasSuffix(item.EntityURN, ":"+profileID) {
+			continue
+		}
+		var distance NetworkFilter
+		if item.MemberDistance != nil {
+			distance = networkDistanceCode(item.MemberDistance.Value)
+		}
+		return distance, int(item.SharedConnectionsCount)
+	}
+	return "", 0
+}
+
 // parseProfilePictureData extracts profile picture information.
-func parseProfilePictureData(apiResponse *ProfileAPIResponse, profileURN string) *ProfilePicture {
-	for _, item := range apiResponse.Included {
-		if item.Type == EntityTypeProfile &&
-			item.EntityURN == profileURN {
-			return &ProfilePicture{
-				DisplayImageUrn: extractProfileImageURN(item),
-				A11yText:        item.FirstName + " " + item.LastName,
+func parseProfilePictureData(idx *includedIndex, profileURN string) *ProfilePicture {
+	if item := idx.profileByURN(profileURN); item != nil {
+		picture := &ProfilePicture{
+			DisplayImageUrn: extractProfileImageURN(*item),
+			A11yText:        item.FirstName + " " + item.LastName,
+		}
+		if item.ProfilePicture != nil {
+			if item.ProfilePicture.A11yText != "" {
+				picture.A11yText = item.ProfilePicture.A11yText
+			}
+			if ref := item.ProfilePicture.DisplayImageReference; ref != nil {
+				picture.RootURL = ref.RootURL
+				picture.URL = largestArtifactURL(ref.RootURL, ref.Artifacts)
+				picture.ExpiresAt = earliestArtifactExpiry(ref.Artifacts)
 			}
 		}
+		return picture
 	}
 
 	return nil
 }
 
+// earliestArtifactExpiry returns the soonest non-zero expiry among the artifacts, or 0.
+func earliestArtifactExpiry(artifacts []VectorArtifactResponse) int64 {
+	var earliest int64
+	for _, artifact := range artifacts {
+		if artifact.ExpiresAt > 0 && (earliest == 0 || artifact.ExpiresAt < earliest) {
+			earliest = artifact.ExpiresAt
+		}
+	}
+	return earliest
+}
+
+// largestArtifactURL joins rootURL with the path segment of the widest artifact, or
+// returns "" when there is no root or artifact to build a URL from.
+func largestArtifactURL(rootURL string, artifacts []VectorArtifactResponse) string {
+	var largest *VectorArtifactResponse
+	for i := range artifacts {
+		if artifacts[i].FileIdentifyingUrlPathSegment == "" {
+			continue
+		}
+		if largest == nil || artifacts[i].Width > largest.Width {
+			largest = &artifacts[i]
+		}
+	}
+	if rootURL == "" || largest == nil {
+		return ""
+	}
+	return rootURL + largest.FileIdentifyingUrlPathSegment
+}
+
 // parseSimpleProfileFields extracts simple fields directly from the profile entity.
-func parseSimpleProfileFields(profile *LinkedInProfile, apiResponse *ProfileAPIResponse, profileEntity *GenericIncludedElement) {
+func parseSimpleProfileFields(profile *LinkedInProfile, profileEntity *GenericIncludedElement) {
 	// Parse creator status
 	if creatorValue, exists := extractFieldFromRawJSON(profileEntity, "creator"); exists {
 		if creator, ok := creatorValue.(bool); ok {
@@ -214,23 +757,141 @@ func parseSimpleProfileFields(profile *LinkedInProfile, apiResponse *ProfileAPIR
 		}
 	}
 
-	// Parse temp status
-	if tempStatusValue, exists := extractFieldFromRawJSON(profileEntity, "tempStatus"); exists {
-		if tempStatus, ok := tempStatusValue.(string); ok {
-			profile.TempStatus = tempStatus
+	// Parse temp status and every "open to" state it is one source of
+	profile.TempStatus = profileEntity.TempStatus
+	profile.TempStatusEmoji = profileEntity.TempStatusEmoji
+	profile.OpenTo = parseOpenTo(profile, profileEntity)
+}
+
+// openToCategories maps LinkedIn's open-to categories, photo frames and statuses to
+// OpenTo values. Keys are compared by their letters only, ignoring case, so
+// "OPEN_TO_WORK" also matches a "#OpenToWork" status.
+var openToCategories = map[string]string{
+	"OPENTOWORK":        OpenToWork,
+	"JOBSEEKING":        OpenToWork,
+	"HIRING":            OpenToHiring,
+	"PROVIDINGSERVICES": OpenToServices,
+	"MENTORING":         OpenToMentoring,
+	"INVESTING":         OpenToInvesting,
+	"VOLUNTEERING":      OpenToVolunteering,
+}
+
+// openToValue returns the OpenTo value for a category, or "" when it is not a known one.
+func openToValue(category string) string {
+	key := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) {
+			return unicode.ToUpper(r)
+		}
+		return -1
+	}, category)
+	return openToCategories[key]
+}
+
+// parseOpenTo collects the member's active open-to states, without duplicates, from the
+// open-to preferences, the photo frame, the temporary status and the services section.
+// It must run after the services are parsed.
+func parseOpenTo(profile *LinkedInProfile, profileEntity *GenericIncludedElement) []string {
+	var openTo []string
+	add := func(value string) {
+		if value != "" && !slices.Contains(openTo, value) {
+			openTo = append(openTo, value)
 		}
 	}
 
-	// Parse temp status emoji
-	if tempEmojiValue, exists := extractFieldFromRawJSON(profileEntity, "tempStatusEmoji"); exists {
-		if tempEmoji, ok := tempEmojiValue.(string); ok {
-			profile.TempStatusEmoji = tempEmoji
+	for _, preference := range profileEntity.OpenToPreferences {
+		if !preference.Active {
+			continue
 		}
+		if value := openToValue(preference.Category); value != "" {
+			add(value)
+		} else {
+			add(strings.ToLower(preference.Category))
+		}
+	}
+	if picture := profileEntity.ProfilePicture; picture != nil {
+		add(openToValue(picture.FrameType))
+	}
+	add(openToValue(profile.TempStatus))
+	if len(profile.Services) > 0 {
+		add(OpenToServices)
 	}
+	return openTo
 }
 
 // Helper functions for parsing specific data types
 
+// dateFromResponse converts an API date into a Date, returning nil for a nil input.
+func dateFromResponse(d *DateResponse) *Date {
+	if d == nil {
+		return nil
+	}
+	return &Date{Year: d.Year, Month: d.Month, Day: d.Day}
+}
+
+// localizeProfile switches the names, headline and summary to the variants the member
+// wrote for locale, where there are any, and records the locale. It reports whether a
+// localized first or last name was found.
+func localizeProfile(profile *LinkedInProfile, profileEntity *GenericIncludedElement, locale string) bool {
+	profile.Locale = locale
+	if headline, ok := localizedValue(profileEntity.MultiLocaleHeadline, locale); ok {
+		profile.Headline = headline
+	}
+	if summary, ok := localizedValue(profileEntity.MultiLocaleSummary, locale); ok {
+		profile.Summary = summary
+	}
+
+	firstName, hasFirstName := localizedValue(profileEntity.MultiLocaleFirstName, locale)
+	lastName, hasLastName := localizedValue(profileEntity.MultiLocaleLastName, locale)
+	if hasFirstName {
+		profile.FirstName = firstName
+	}
+	if hasLastName {
+		profile.LastName = lastName
+	}
+	return hasFirstName || hasLastName
+}
+
+// localeResponse splits a locale such as "ja_JP" or "ja-JP" into a LocaleResponse.
+func localeResponse(locale string) *LocaleResponse {
+	language, country, _ := strings.Cut(strings.ReplaceAll(locale, "-", "_"), "_")
+	return &LocaleResponse{Language: language, Country: country}
+}
+
+// familyFirstLanguages lists locale languages whose names are written family name first.
+var familyFirstLanguages = map[string]bool{
+	"ja": true, // Japanese
+	"ko": true, // Korean
+	"zh": true, // Chinese
+	"hu": true, // Hungarian
+	"vi": true, // Vietnamese
+}
+
+// resolveNameFormat returns the configured name format, or derives one from the locale.
+func resolveNameFormat(configured string, locale *LocaleResponse) string {
+	if configured != "" {
+		return configured
+	}
+	if locale != nil && familyFirstLanguages[strings.ToLower(locale.Language)] {
+		return NameFormatFamilyGiven
+	}
+	return NameFormatGivenFamily
+}
+
+// assembleFullName joins the non-empty name parts in the given order without stray spaces.
+func assembleFullName(firstName, lastName, nameFormat string) string {
+	parts := []string{strings.TrimSpace(firstName), strings.TrimSpace(lastName)}
+	if nameFormat == NameFormatFamilyGiven {
+		parts[0], parts[1] = parts[1], parts[0]
+	}
+	var nonEmpty []string
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return strings.Join(nonEmpty, " ")
+}
+
 // extractCountryCode extracts country code from a profile entity.
 func extractCountryCode(item GenericIncludedElement) string {
 	// This would need to be implemented based on actual API response structure
@@ -252,7 +913,9 @@ func parseFollowerCount(item GenericIncludedElement) (int, error) {
 
 // extractProfileImageURN extracts profile image URN from a profile entity.
 func extractProfileImageURN(item GenericIncludedElement) string {
-	// This would need to be implemented based on actual API response structure
+	if item.ProfilePicture != nil {
+		return item.ProfilePicture.DisplayImageUrn
+	}
 	return ""
 }
 
@@ -264,6 +927,49 @@ func extractFieldFromRawJSON(item *GenericIncludedElement, fieldName string) (in
 	return nil, false
 }
 
+// computeParseStats compares, per section, the included entities whose $type mentions the
+// section with the entries that ended up on the parsed profile.
+func computeParseStats(apiResponse *ProfileAPIResponse, profile *LinkedInProfile) ParseStats {
+	var stats ParseStats
+	for _, item := range apiResponse.Included {
+		switch {
+		case strings.Contains(item.Type, "Position"):
+			stats.Experience.Seen++
+		case strings.Contains(item.Type, "Education"):
+			stats.Education.Seen++
+		case item.Type == EntityTypeTopSkills:
+			// The top card repeats skill names, it is not part of the skills section
+		case strings.Contains(item.Type, "Skill"):
+			stats.Skills.Seen++
+		case strings.Contains(item.Type, "Certification"):
+			stats.Certifications.Seen++
+		}
+	}
+	if profileEntity := findProfileEntityByURN(apiResponse, profile.URN); profileEntity != nil {
+		if positions := profileEntity.ProfilePositions; positions != nil && positions.Paging != nil {
+			stats.Experience.Total = positions.Paging.Total
+		}
+		if educations := profileEntity.ProfileEducations; educations != nil && educations.Paging != nil {
+			stats.Education.Total = educations.Paging.Total
+		}
+	}
+	stats.Experience.Parsed = len(profile.Experience)
+	stats.Education.Parsed = len(profile.Education)
+	stats.Skills.Parsed = len(profile.Skills)
+	stats.Certifications.Parsed = len(profile.Certifications)
+	return stats
+}
+
+// findProfileEntityByURN returns the Profile entity with the given entity URN, or nil.
+func findProfileEntityByURN(apiResponse *ProfileAPIResponse, urn string) *GenericIncludedElement {
+	for i, item := range apiResponse.Included {
+		if item.Type == EntityTypeProfile && item.EntityURN == urn {
+			return &apiResponse.Included[i]
+		}
+	}
+	return nil
+}
+
 // validateProfileData validates and sanitizes profile data.
 func validateProfileData(profile *LinkedInProfile) error {
 	if profile == nil {
@@ -304,10 +1010,16 @@ func ParseFromJSON(jsonData []byte) (*LinkedInProfile, error) {
 	// Extract public identifier from the response
 	publicIdentifier := extractPublicIdentifierFromResponse(&apiResponse)
 	if publicIdentifier == "" {
+		// Feed and notification payloads carry only MiniProfiles
+		for _, item := range apiResponse.Included {
+			if item.Type == EntityTypeMiniProfile {
+				return ParseMiniProfile(jsonData)
+			}
+		}
 		return nil, fmt.Errorf("could not extract publicIdentifier from response")
 	}
 
-	profile, err := parseProfileFromAPIResponse(&apiResponse, publicIdentifier)
+	profile, err := parseProfileFromAPIResponse(&apiResponse, publicIdentifier, parseOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse profile: %w", err)
 	}
@@ -331,6 +1043,70 @@ func extractPublicIdentifierFromResponse(apiResponse *ProfileAPIResponse) string
 	return ""
 }
 
+// ParseMiniProfile parses a MiniProfile, the compact shape feed and notification responses
+// use for members. jsonData may be a single MiniProfile entity or a response whose
+// "included" array holds one, in which case the first MiniProfile with a public identifier
+// is returned. Only the identity, name, headline and picture are available in this shape.
+func ParseMiniProfile(jsonData []byte) (*LinkedInProfile, error) {
+	var envelope struct {
+		Type     string            `json:"$type"`
+		Included []json.RawMessage `json:"included"`
+	}
+	if err := json.Unmarshal(jsonData, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+	}
+
+	candidates := envelope.Included
+	if envelope.Type == EntityTypeMiniProfile {
+		candidates = []json.RawMessage{jsonData}
+	}
+	for _, raw := range candidates {
+		var typed struct {
+			Type string `json:"$type"`
+		}
+		if err := json.Unmarshal(raw, &typed); err != nil || typed.Type != EntityTypeMiniProfile {
+			continue
+		}
+		var mini MiniProfileResponse
+		if err := json.Unmarshal(raw, &mini); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrResponseParseFailed, err)
+		}
+		if mini.PublicIdentifier == "" {
+			continue
+		}
+
+		profile := convertMiniProfile(&mini)
+		if err := validateProfileData(profile); err != nil {
+			return nil, fmt.Errorf("profile validation failed: %w", err)
+		}
+		return profile, nil
+	}
+	return nil, fmt.Errorf("could not find a miniProfile with a publicIdentifier in response")
+}
+
+// convertMiniProfile builds a LinkedInProfile from a MiniProfile entity.
+func convertMiniProfile(mini *MiniProfileResponse) *LinkedInProfile {
+	profile := &LinkedInProfile{
+		PublicIdentifier: mini.PublicIdentifier,
+		URN:              mini.EntityURN,
+		FirstName:        mini.FirstName,
+		LastName:         mini.LastName,
+		FullName:         assembleFullName(mini.FirstName, mini.LastName, ""),
+		Headline:         mini.Occupation,
+		TrackingID:       mini.TrackingID,
+		ProfileURL:       fmt.Sprintf("https://www.linkedin.com/in/%s/", mini.PublicIdentifier),
+	}
+	if mini.Picture != nil && mini.Picture.VectorImage != nil {
+		profile.ProfilePicture = &ProfilePicture{
+			RootURL:   mini.Picture.VectorImage.RootURL,
+			URL:       largestArtifactURL(mini.Picture.VectorImage.RootURL, mini.Picture.VectorImage.Artifacts),
+			A11yText:  strings.TrimSpace(mini.FirstName + " " + mini.LastName),
+			ExpiresAt: earliestArtifactExpiry(mini.Picture.VectorImage.Artifacts),
+		}
+	}
+	return profile
+}
+
 // Advanced parsing functions for complex nested structures
 
 // parseVectorImage parses vector image data from the API response.
@@ -354,8 +1130,8 @@ func parseTextViewModel(rawData interface{}) string {
 }
 
 // convertAPIResponseToLinkedInProfile is the main conversion function used by the client.
-func convertAPIResponseToLinkedInProfile(apiResponse *ProfileAPIResponse, publicIdentifier string) (*LinkedInProfile, error) {
-	profile, err := parseProfileFromAPIResponse(apiResponse, publicIdentifier)
+func convertAPIResponseToLinkedInProfile(apiResponse *ProfileAPIResponse, publicIdentifier string, opts parseOptions) (*LinkedInProfile, error) {
+	profile, err := parseProfileFromAPIResponse(apiResponse, publicIdentifier, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -367,3 +1143,96 @@ func convertAPIResponseToLinkedInProfile(apiResponse *ProfileAPIResponse, public
 
 	return profile, nil
 }
+
+// convertAPIResponseToLiteProfile converts a profile response into a top-card-only profile
+// for GetProfileLite.
+func convertAPIResponseToLiteProfile(apiResponse *ProfileAPIResponse, publicIdentifier string, opts parseOptions) (*LinkedInProfile, error) {
+	profileEntity, err := findProfileEntity(apiResponse, publicIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := parseProfileTopCard(newIncludedIndex(apiResponse.Included), profileEntity, opts)
+	if err := validateProfileData(profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// convertMemberResponseToLinkedInProfile converts a profile response fetched by member
+// identity, locating the profile entity by its object URN (e.g. "urn:li:member:123").
+func convertMemberResponseToLinkedInProfile(apiResponse *ProfileAPIResponse, memberURN string, opts parseOptions) (*LinkedInProfile, error) {
+	var profileEntity *GenericIncludedElement
+	for i, item := range apiResponse.Included {
+		if item.Type == EntityTypeProfile && item.ObjectURN == memberURN {
+			profileEntity = &apiResponse.Included[i]
+			break
+		}
+	}
+	if profileEntity == nil {
+		if len(apiResponse.Included) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrEmptyResponse, memberURN)
+		}
+		return nil, fmt.Errorf("profile not found in API response for member: %s", memberURN)
+	}
+
+	profile, err := parseProfileEntity(newIncludedIndex(apiResponse.Included), profileEntity, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateProfileData(profile); err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// convertBatchResponseToLinkedInProfiles converts a batched profile response, matching each
+// requested URN against the profile entities' entity or object URN. Section entities are
+// attributed to a profile by the profile ID embedded in their URN, since a batched response
+// mixes the sections of every member.
+func convertBatchResponseToLinkedInProfiles(apiResponse *ProfileAPIResponse, urns []string, opts parseOptions) ([]*LinkedInProfile, error) {
+	if len(apiResponse.Included) == 0 && len(urns) > 0 {
+		return nil, ErrEmptyResponse
+	}
+
+	var profiles []*LinkedInProfile
+	for _, urn := range urns {
+		var profileEntity *GenericIncludedElement
+		for i, item := range apiResponse.Included {
+			if item.Type == EntityTypeProfile && (item.EntityURN == urn || item.ObjectURN == urn) {
+				profileEntity = &apiResponse.Included[i]
+				break
+			}
+		}
+		if profileEntity == nil {
+			continue
+		}
+
+		idx := newIncludedIndex(profileScopedIncluded(apiResponse.Included, profileEntity.EntityURN))
+		profile, err := parseProfileEntity(idx, profileEntity, opts)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", urn, err)
+		}
+		if err := validateProfileData(profile); err != nil {
+			return nil, fmt.Errorf("%s: %w", urn, err)
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
+
+// profileScopedIncluded drops the section entities (positions, education, ...) that belong
+// to other profiles, recognised by their URN key not starting with profileURN's ID, e.g.
+// "urn:li:fsd_profilePosition:(ACoAAAJaneDoe,2)" belongs to "urn:li:fsd_profile:ACoAAAJaneDoe".
+func profileScopedIncluded(included []GenericIncludedElement, profileURN string) []GenericIncludedElement {
+	profileID := profileURN[strings.LastIndex(profileURN, ":")+1:]
+	scoped := make([]GenericIncludedElement, 0, len(included))
+	for _, item := range included {
+		if strings.Contains(item.EntityURN, ":(") && !strings.Contains(item.EntityURN, ":("+profileID+",") {
+			continue
+		}
+		scoped = append(scoped, item)
+	}
+	return scoped
+}
@@ -0,0 +1,44 @@
+package linkedinscraper_test
+
+import (
+	"fmt"
+	"testing"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+// largeProfileResponse builds a profile response whose included array holds the profile
+// entity followed by n-1 section entities spread across positions, education and skills.
+func largeProfileResponse(n int) *linkedinscraper.ProfileAPIResponse {
+	included := []linkedinscraper.GenericIncludedElement{{
+		Type:             linkedinscraper.EntityTypeProfile,
+		EntityURN:        "urn:li:fsd_profile:ACoAAAbench",
+		PublicIdentifier: "bench",
+		FirstName:        "Bench",
+		LastName:         "Mark",
+	}}
+	types := []string{
+		linkedinscraper.EntityTypePosition,
+		linkedinscraper.EntityTypeEducation,
+		linkedinscraper.EntityTypeEndorsedSkill,
+	}
+	for i := 1; i < n; i++ {
+		included = append(included, linkedinscraper.GenericIncludedElement{
+			Type:      types[i%len(types)],
+			EntityURN: fmt.Sprintf("urn:li:fsd_entity:(ACoAAAbench,%d)", i),
+			Name:      fmt.Sprintf("Entity %d", i),
+		})
+	}
+	return &linkedinscraper.ProfileAPIResponse{Included: included}
+}
+
+func BenchmarkParseProfileLargeIncluded(b *testing.B) {
+	apiResponse := largeProfileResponse(2000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := linkedinscraper.ParseProfileResponse(apiResponse, "bench"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,374 @@
+package linkedinscraper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxFlatMapSkills caps the number of skills joined into FlatMap's "skills" key.
+const maxFlatMapSkills = 10
+
+// FlatMap flattens key profile fields into a single string map, which keeps
+// text/template reports free of nested-struct logic. Keys are: full_name, headline,
+// current_company, location, profile_url, connection_count and skills (the first
+// ten skill names, comma-joined). Missing values are empty strings.
+func (p *LinkedInProfile) FlatMap() map[string]string {
+	flat := map[string]string{
+		"full_name":        p.FullName,
+		"headline":         p.Headline,
+		"current_company":  p.currentCompany(),
+		"location":         p.Location,
+		"profile_url":      p.ProfileURL,
+		"connection_count": "",
+		"skills":           "",
+	}
+
+	if p.ConnectionInfo != nil {
+		flat["connection_count"] = strconv.Itoa(p.ConnectionInfo.ConnectionCount)
+	}
+
+	var skills []string
+	for _, skill := range p.Skills {
+		if len(skills) == maxFlatMapSkills {
+			break
+		}
+		if skill.Name != "" {
+			skills = append(skills, skill.Name)
+		}
+	}
+	flat["skills"] = strings.Join(skills, ", ")
+
+	return flat
+}
+
+// currentCompany returns the company of the first ongoing position, falling back
+// to the first listed position when none is marked ongoing, then to CurrentCompany.
+func (p *LinkedInProfile) currentCompany() string {
+	for _, exp := range p.Experience {
+		if exp.DateRange != nil && exp.DateRange.End == nil && exp.CompanyName != "" {
+			return exp.CompanyName
+		}
+	}
+	if len(p.Experience) > 0 {
+		return p.Experience[0].CompanyName
+	}
+	return p.CurrentCompany
+}
+
+// IdentityKey returns a stable key for deduplicating profiles across sessions, from the
+// most to the least reliable identity available:
+//
+//   - the URN, with the profile namespaces (fsd_profile, fs_miniProfile, ...) mapped to one
+//     "profile:<id>" key; member URNs are kept as they are
+//   - "public:" and the public identifier normalized with NormalizePublicIdentifier
+//   - "hash:" and a SHA-256 of the case-folded full name and headline
+//
+// It returns "" for a profile with none of these, which should not be deduplicated.
+// A search result and a full profile of the same member may key differently when one
+// carries a member URN and the other a profile URN.
+func (p *LinkedInProfile) IdentityKey() string {
+	if urn := strings.TrimSpace(p.URN); urn != "" {
+		return profileURNKey(urn)
+	}
+	if publicIdentifier := NormalizePublicIdentifier(p.PublicIdentifier); publicIdentifier != "" {
+		return "public:" + publicIdentifier
+	}
+	name := strings.ToLower(strings.Join(strings.Fields(p.FullName), " "))
+	headline := strings.ToLower(strings.Join(strings.Fields(p.Headline), " "))
+	if name == "" && headline == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(name + "\n" + headline))
+	return "hash:" + hex.EncodeToString(sum[:])
+}
+
+// IsURLExpired reports whether the signed picture URL has expired as of now.
+// It returns false when the expiry is unknown or the picture is nil.
+func (p *ProfilePicture) IsURLExpired(now time.Time) bool {
+	if p == nil || p.ExpiresAt == 0 {
+		return false
+	}
+	return !now.Before(time.UnixMilli(p.ExpiresAt))
+}
+
+// ToTime converts d to a UTC time.Time, defaulting a missing month or day to 1.
+// The bool is false when d is nil or has no year, in which case the date carries
+// no meaningful point in time.
+func (d *Date) ToTime() (time.Time, bool) {
+	if d == nil || d.Year <= 0 {
+		return time.Time{}, false
+	}
+	month, day := d.Month, d.Day
+	if month < 1 || month > 12 {
+		month = 1
+	}
+	if day < 1 {
+		day = 1
+	}
+	return time.Date(d.Year, time.Month(month), day, 0, 0, 0, 0, time.UTC), true
+}
+
+// DateRangeString formats the role's dates for display, e.g. "Jan 2020 – Present" or
+// "2015 – Mar 2018". Dates without a month show the year only, a role without an end
+// date is "Present", and a role without any dates yields "".
+func (e Experience) DateRangeString() string {
+	if e.DateRange == nil {
+		return ""
+	}
+	start, end := displayDate(e.DateRange.Start), displayDate(e.DateRange.End)
+	switch {
+	case start == "":
+		return end
+	case end == "":
+		return start + " – Present"
+	default:
+		return start + " – " + end
+	}
+}
+
+// displayDate formats d as "Jan 2020", or "2020" when the month is unknown. It returns
+// "" for a nil date or one without a year.
+func displayDate(d *Date) string {
+	if d == nil || d.Year <= 0 {
+		return ""
+	}
+	if d.Month < 1 || d.Month > 12 {
+		return strconv.Itoa(d.Year)
+	}
+	return time.Month(d.Month).String()[:3] + " " + strconv.Itoa(d.Year)
+}
+
+// IsOpenToWork reports whether the member signals they are looking for a job, see OpenTo.
+func (p *LinkedInProfile) IsOpenToWork() bool {
+	return slices.Contains(p.OpenTo, OpenToWork)
+}
+
+// HeadlineForLocale returns the headline written for locale (e.g. "fr_FR" or "fr-FR"),
+// falling back to another variant in the same language and then to Headline.
+func (p *LinkedInProfile) HeadlineForLocale(locale string) string {
+	if headline, ok := localizedValue(p.MultiLocaleHeadline, locale); ok {
+		return headline
+	}
+	return p.Headline
+}
+
+// localizedValue returns the value of a multi-locale map for locale, falling back to the
+// first variant (by key) in the same language. It reports whether either was found.
+func localizedValue(values map[string]string, locale string) (string, bool) {
+	want := strings.ToLower(strings.ReplaceAll(locale, "-", "_"))
+	language, _, _ := strings.Cut(want, "_")
+
+	var sameLanguage string
+	for key, value := range values {
+		normalized := strings.ToLower(strings.ReplaceAll(key, "-", "_"))
+		if normalized == want {
+			return value, true
+		}
+		if keyLanguage, _, _ := strings.Cut(normalized, "_"); keyLanguage == language {
+			if sameLanguage == "" || key < sameLanguage {
+				sameLanguage = key
+			}
+		}
+	}
+	if sameLanguage != "" {
+		return values[sameLanguage], true
+	}
+	return "", false
+}
+
+// minEmploymentGapMonths is the shortest stretch without a role that EmploymentGaps reports.
+const minEmploymentGapMonths = 3
+
+// ongoingMonth stands in for the end of a role without an end date.
+const ongoingMonth = math.MaxInt
+
+// startMonth returns the month index (year*12 + month-1) a date range starts in,
+// taking January when only the year is known. It reports false without a start year.
+func startMonth(r *DateRange) (int, bool) {
+	if r == nil || r.Start == nil || r.Start.Year <= 0 {
+		return 0, false
+	}
+	month := r.Start.Month
+	if month < 1 || month > 12 {
+		month = 1
+	}
+	return r.Start.Year*12 + month - 1, true
+}
+
+// endMonth returns the month index just after a date range ends, treating a year-only
+// end as covering the whole year and a missing end as ongoing.
+func endMonth(r *DateRange) int {
+	if r == nil || r.End == nil || r.End.Year <= 0 {
+		return ongoingMonth
+	}
+	if r.End.Month < 1 || r.End.Month > 12 {
+		return (r.End.Year + 1) * 12
+	}
+	return r.End.Year*12 + r.End.Month
+}
+
+// monthDate converts a month index back to a year-and-month Date.
+func monthDate(month int) *Date {
+	return &Date{Year: month / 12, Month: month%12 + 1}
+}
+
+// SortedExperience returns the experience entries in reverse-chronological order:
+// ongoing roles first, then by end and start date, most recent first. Entries without
+// a start date keep their relative order at the end. p.Experience is not modified.
+func (p *LinkedInProfile) SortedExperience() []Experience {
+	sorted := append([]Experience(nil), p.Experience...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return moreRecent(sorted[i].DateRange, sorted[j].DateRange)
+	})
+	return sorted
+}
+
+// moreRecent reports whether date range a sorts before b in reverse-chronological order:
+// ongoing first, then by end and start date. Undated ranges sort last.
+func moreRecent(a, b *DateRange) bool {
+	aStart, aDated := startMonth(a)
+	bStart, bDated := startMonth(b)
+	if aDated != bDated {
+		return aDated
+	}
+	if !aDated {
+		return false
+	}
+	if aEnd, bEnd := endMonth(a), endMonth(b); aEnd != bEnd {
+		return aEnd > bEnd
+	}
+	return aStart > bStart
+}
+
+// EmploymentGaps returns the stretches of three or more months between roles during which
+// no role was active, oldest first, as year-and-month ranges with inclusive ends. Overlapping
+// roles are merged, so concurrent positions never produce gaps. Missing dates are read
+// conservatively to avoid false gaps: a year-only start counts from January, a year-only
+// end through December, a missing end as ongoing, and a role with an end but no start
+// covers all the time up to its end. Roles with neither date are ignored. Time after the
+// last role is not reported.
+func (p *LinkedInProfile) EmploymentGaps() []DateRange {
+	type interval struct{ start, end int }
+	var intervals []interval
+	for _, exp := range p.Experience {
+		if start, ok := startMonth(exp.DateRange); ok {
+			intervals = append(intervals, interval{start, endMonth(exp.DateRange)})
+		} else if end := endMonth(exp.DateRange); end != ongoingMonth {
+			intervals = append(intervals, interval{0, end})
+		}
+	}
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i].start < intervals[j].start })
+
+	var gaps []DateRange
+	for i := 0; i < len(intervals); i++ {
+		coveredUntil := intervals[i].end
+		for i+1 < len(intervals) && intervals[i+1].start <= coveredUntil {
+			i++
+			coveredUntil = max(coveredUntil, intervals[i].end)
+		}
+		if i+1 < len(intervals) && intervals[i+1].start-coveredUntil >= minEmploymentGapMonths {
+			gaps = append(gaps, DateRange{Start: monthDate(coveredUntil), End: monthDate(intervals[i+1].start - 1)})
+		}
+	}
+	return gaps
+}
+
+// Completeness score weights, summing to 100.
+const (
+	completenessHeadline   = 15
+	completenessSummary    = 15
+	completenessExperience = 20
+	completenessEducation  = 15
+	completenessSkills     = 15
+	completenessPicture    = 10
+	completenessLocation   = 10
+)
+
+// CompletenessScore rates how filled-in the profile is from 0 to 100 by adding a fixed
+// weight for each section present: experience 20; headline, summary, education and
+// skills 15 each; profile picture and location 10 each. A section counts as present with
+// any non-empty value; a picture needs an image URN or URL, not just alt text.
+func (p *LinkedInProfile) CompletenessScore() int {
+	score := 0
+	if strings.TrimSpace(p.Headline) != "" {
+		score += completenessHeadline
+	}
+	if strings.TrimSpace(p.Summary) != "" {
+		score += completenessSummary
+	}
+	if len(p.Experience) > 0 {
+		score += completenessExperience
+	}
+	if len(p.Education) > 0 {
+		score += completenessEducation
+	}
+	if len(p.Skills) > 0 {
+		score += completenessSkills
+	}
+	if p.ProfilePicture != nil && (p.ProfilePicture.DisplayImageUrn != "" || p.ProfilePicture.RootURL != "") {
+		score += completenessPicture
+	}
+	if p.Location != "" || p.DisplayLocation != "" {
+		score += completenessLocation
+	}
+	return score
+}
+
+// CompactJSON marshals the profile like json.Marshal, then drops null values and empty
+// objects and arrays at any depth, such as an allocated ConnectionInfo whose counts are
+// all zero. Object keys come out sorted. Empty strings and zero numbers are kept unless
+// their field is already omitempty.
+func (p *LinkedInProfile) CompactJSON() ([]byte, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber() // Keep large integers such as picture expiries exact
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	compacted, _ := compactJSONValue(value)
+	if compacted == nil {
+		compacted = map[string]interface{}{}
+	}
+	return json.Marshal(compacted)
+}
+
+// compactJSONValue strips nulls and empty objects and arrays from a decoded JSON value,
+// reporting false when the value itself ends up empty.
+func compactJSONValue(value interface{}) (interface{}, bool) {
+	switch v := value.(type) {
+	case nil:
+		return nil, false
+	case map[string]interface{}:
+		for key, child := range v {
+			if compacted, ok := compactJSONValue(child); ok {
+				v[key] = compacted
+			} else {
+				delete(v, key)
+			}
+		}
+		return v, len(v) > 0
+	case []interface{}:
+		kept := v[:0]
+		for _, child := range v {
+			if compacted, ok := compactJSONValue(child); ok {
+				kept = append(kept, compacted)
+			}
+		}
+		return kept, len(kept) > 0
+	}
+	return value, true
+}
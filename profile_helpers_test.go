@@ -0,0 +1,308 @@
+package linkedinscraper_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("LinkedInProfile helpers", func() {
+	Describe("FlatMap", func() {
+		It("flattens key fields into template-friendly keys", func() {
+			var skills []linkedinscraper.Skill
+			for i := 1; i <= 12; i++ {
+				skills = append(skills, linkedinscraper.Skill{Name: fmt.Sprintf("Skill %d", i)})
+			}
+			profile := &linkedinscraper.LinkedInProfile{
+				FullName:   "Jane Doe",
+				Headline:   "Investor",
+				Location:   "Paris, France",
+				ProfileURL: "https://www.linkedin.com/in/jane-doe/",
+				Experience: []linkedinscraper.Experience{
+					{CompanyName: "Former Corp", DateRange: &linkedinscraper.DateRange{
+						Start: &linkedinscraper.Date{Year: 2015}, End: &linkedinscraper.Date{Year: 2018},
+					}},
+					{CompanyName: "Acme Capital", DateRange: &linkedinscraper.DateRange{
+						Start: &linkedinscraper.Date{Year: 2018},
+					}},
+				},
+				ConnectionInfo: &linkedinscraper.ConnectionInfo{ConnectionCount: 500},
+				Skills:         skills,
+			}
+
+			flat := profile.FlatMap()
+
+			Expect(flat).To(HaveLen(7))
+			Expect(flat).To(HaveKey("full_name"))
+			Expect(flat).To(HaveKey("headline"))
+			Expect(flat).To(HaveKey("location"))
+			Expect(flat).To(HaveKey("profile_url"))
+			Expect(flat["current_company"]).To(Equal("Acme Capital"))
+			Expect(flat["connection_count"]).To(Equal("500"))
+			Expect(flat["skills"]).To(HavePrefix("Skill 1, Skill 2"))
+			Expect(flat["skills"]).To(HaveSuffix("Skill 10"))
+		})
+
+		It("returns empty values for a sparse profile", func() {
+			flat := (&linkedinscraper.LinkedInProfile{FullName: "Jane Doe"}).FlatMap()
+
+			Expect(flat["full_name"]).To(Equal("Jane Doe"))
+			Expect(flat["current_company"]).To(BeEmpty())
+			Expect(flat["connection_count"]).To(BeEmpty())
+			Expect(flat["skills"]).To(BeEmpty())
+		})
+	})
+
+	Describe("IdentityKey", func() {
+		DescribeTable("uses the most reliable identity available",
+			func(profile linkedinscraper.LinkedInProfile, expected string) {
+				Expect(profile.IdentityKey()).To(Equal(expected))
+			},
+			Entry("profile URN", linkedinscraper.LinkedInProfile{URN: "urn:li:fsd_profile:ACoAAAJaneDoe", PublicIdentifier: "jane-doe"}, "profile:ACoAAAJaneDoe"),
+			Entry("mini profile URN", linkedinscraper.LinkedInProfile{URN: "urn:li:fs_miniProfile:ACoAAAJaneDoe"}, "profile:ACoAAAJaneDoe"),
+			Entry("member URN", linkedinscraper.LinkedInProfile{URN: "urn:li:member:123", FullName: "Jane Doe"}, "urn:li:member:123"),
+			Entry("public identifier", linkedinscraper.LinkedInProfile{PublicIdentifier: "Jane-Doe/", FullName: "Jane Doe"}, "public:jane-doe"),
+			Entry("nothing to identify", linkedinscraper.LinkedInProfile{}, ""),
+		)
+
+		It("falls back to a hash of name and headline", func() {
+			key := (&linkedinscraper.LinkedInProfile{FullName: "Jane Doe", Headline: "Investor"}).IdentityKey()
+			Expect(key).To(MatchRegexp(`^hash:[0-9a-f]{64}$`))
+
+			same := (&linkedinscraper.LinkedInProfile{FullName: " jane  DOE", Headline: "investor "}).IdentityKey()
+			other := (&linkedinscraper.LinkedInProfile{FullName: "Jane Doe", Headline: "Founder"}).IdentityKey()
+			Expect(same).To(Equal(key))
+			Expect(other).NotTo(Equal(key))
+		})
+	})
+
+	Describe("ProfilePicture.IsURLExpired", func() {
+		now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		DescribeTable("compares the artifact expiry with now",
+			func(picture *linkedinscraper.ProfilePicture, expired bool) {
+				Expect(picture.IsURLExpired(now)).To(Equal(expired))
+			},
+			Entry("past expiry", &linkedinscraper.ProfilePicture{ExpiresAt: now.Add(-time.Hour).UnixMilli()}, true),
+			Entry("future expiry", &linkedinscraper.ProfilePicture{ExpiresAt: now.Add(time.Hour).UnixMilli()}, false),
+			Entry("expiry exactly now", &linkedinscraper.ProfilePicture{ExpiresAt: now.UnixMilli()}, true),
+			Entry("unknown expiry", &linkedinscraper.ProfilePicture{}, false),
+			Entry("nil picture", (*linkedinscraper.ProfilePicture)(nil), false),
+		)
+	})
+
+	Describe("Date.ToTime", func() {
+		DescribeTable("converts LinkedIn dates",
+			func(date *linkedinscraper.Date, expected time.Time, ok bool) {
+				t, complete := date.ToTime()
+				Expect(complete).To(Equal(ok))
+				Expect(t).To(Equal(expected))
+			},
+			Entry("full date", &linkedinscraper.Date{Year: 2020, Month: 3, Day: 15}, time.Date(2020, time.March, 15, 0, 0, 0, 0, time.UTC), true),
+			Entry("year and month", &linkedinscraper.Date{Year: 2020, Month: 3}, time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC), true),
+			Entry("year only", &linkedinscraper.Date{Year: 2020}, time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), true),
+			Entry("no year", &linkedinscraper.Date{Month: 3}, time.Time{}, false),
+			Entry("nil", (*linkedinscraper.Date)(nil), time.Time{}, false),
+		)
+	})
+
+	Describe("Experience.DateRangeString", func() {
+		DescribeTable("formats the range for display",
+			func(dateRange *linkedinscraper.DateRange, expected string) {
+				Expect(linkedinscraper.Experience{DateRange: dateRange}.DateRangeString()).To(Equal(expected))
+			},
+			Entry("closed range",
+				&linkedinscraper.DateRange{Start: &linkedinscraper.Date{Year: 2018, Month: 6}, End: &linkedinscraper.Date{Year: 2019, Month: 12}},
+				"Jun 2018 – Dec 2019"),
+			Entry("ongoing role",
+				&linkedinscraper.DateRange{Start: &linkedinscraper.Date{Year: 2020, Month: 1}},
+				"Jan 2020 – Present"),
+			Entry("missing months",
+				&linkedinscraper.DateRange{Start: &linkedinscraper.Date{Year: 2015}, End: &linkedinscraper.Date{Year: 2018, Month: 3}},
+				"2015 – Mar 2018"),
+			Entry("ongoing role with year only",
+				&linkedinscraper.DateRange{Start: &linkedinscraper.Date{Year: 2021}, End: &linkedinscraper.Date{}},
+				"2021 – Present"),
+			Entry("end date only",
+				&linkedinscraper.DateRange{End: &linkedinscraper.Date{Year: 2012, Month: 9}},
+				"Sep 2012"),
+			Entry("empty range", &linkedinscraper.DateRange{}, ""),
+			Entry("no dates", (*linkedinscraper.DateRange)(nil), ""),
+		)
+	})
+
+	Describe("HeadlineForLocale", func() {
+		var profile *linkedinscraper.LinkedInProfile
+
+		BeforeEach(func() {
+			var err error
+			profile, err = linkedinscraper.ParseFromJSON(loadFixture("profile_multilocale.json"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("captures every locale variant", func() {
+			Expect(profile.MultiLocaleHeadline).To(Equal(map[string]string{
+				"en_US": "Research Director",
+				"fr_FR": "Directrice de recherche",
+			}))
+		})
+
+		DescribeTable("picks a variant with fallback",
+			func(locale, expected string) {
+				Expect(profile.HeadlineForLocale(locale)).To(Equal(expected))
+			},
+			Entry("exact locale", "fr_FR", "Directrice de recherche"),
+			Entry("hyphenated, different case", "FR-fr", "Directrice de recherche"),
+			Entry("same language, other country", "fr_CA", "Directrice de recherche"),
+			Entry("language only", "en", "Research Director"),
+			Entry("unknown locale falls back to Headline", "de_DE", "Research Director"),
+		)
+
+		It("falls back to Headline without variants", func() {
+			p := &linkedinscraper.LinkedInProfile{Headline: "Engineer"}
+			Expect(p.HeadlineForLocale("fr_FR")).To(Equal("Engineer"))
+		})
+	})
+
+	Describe("experience timeline", func() {
+		role := func(company string, start, end *linkedinscraper.Date) linkedinscraper.Experience {
+			return linkedinscraper.Experience{
+				CompanyName: company,
+				DateRange:   &linkedinscraper.DateRange{Start: start, End: end},
+			}
+		}
+		ym := func(year, month int) *linkedinscraper.Date {
+			return &linkedinscraper.Date{Year: year, Month: month}
+		}
+
+		It("sorts ongoing roles first, then most recent", func() {
+			profile := &linkedinscraper.LinkedInProfile{Experience: []linkedinscraper.Experience{
+				role("Oldest", ym(2010, 1), ym(2012, 6)),
+				{CompanyName: "Undated"},
+				role("Current", ym(2019, 4), nil),
+				role("Recent", ym(2015, 2), ym(2019, 3)),
+				role("Side project", ym(2021, 1), nil),
+			}}
+
+			var companies []string
+			for _, exp := range profile.SortedExperience() {
+				companies = append(companies, exp.CompanyName)
+			}
+			Expect(companies).To(Equal([]string{"Side project", "Current", "Recent", "Oldest", "Undated"}))
+			Expect(profile.Experience[0].CompanyName).To(Equal("Oldest"))
+		})
+
+		DescribeTable("EmploymentGaps",
+			func(experience []linkedinscraper.Experience, expected []linkedinscraper.DateRange) {
+				profile := &linkedinscraper.LinkedInProfile{Experience: experience}
+				Expect(profile.EmploymentGaps()).To(Equal(expected))
+			},
+			Entry("back-to-back roles", []linkedinscraper.Experience{
+				role("A", ym(2014, 9), ym(2018, 2)),
+				role("B", ym(2018, 3), nil),
+			}, nil),
+			Entry("a six-month gap", []linkedinscraper.Experience{
+				role("B", ym(2018, 9), nil),
+				role("A", ym(2014, 9), ym(2018, 2)),
+			}, []linkedinscraper.DateRange{{Start: ym(2018, 3), End: ym(2018, 8)}}),
+			Entry("a two-month break is not a gap", []linkedinscraper.Experience{
+				role("A", ym(2014, 9), ym(2018, 2)),
+				role("B", ym(2018, 5), nil),
+			}, nil),
+			Entry("concurrent roles cover each other", []linkedinscraper.Experience{
+				role("Long", ym(2010, 1), ym(2020, 12)),
+				role("Short", ym(2012, 1), ym(2012, 6)),
+				role("Later", ym(2015, 1), ym(2016, 1)),
+				role("Next", ym(2021, 1), nil),
+			}, nil),
+			Entry("a year-only end covers the whole year", []linkedinscraper.Experience{
+				role("A", &linkedinscraper.Date{Year: 2010}, &linkedinscraper.Date{Year: 2012}),
+				role("B", ym(2013, 2), nil),
+			}, nil),
+			Entry("an ongoing role hides later gaps", []linkedinscraper.Experience{
+				role("Ongoing", ym(2010, 1), nil),
+				role("Contract", ym(2015, 1), ym(2015, 3)),
+				role("Contract", ym(2019, 1), ym(2019, 3)),
+			}, nil),
+			Entry("a role without a start covers the time up to its end", []linkedinscraper.Experience{
+				role("A", ym(2010, 1), ym(2010, 12)),
+				role("Unknown", nil, ym(2011, 6)),
+				role("B", ym(2012, 1), nil),
+			}, []linkedinscraper.DateRange{{Start: ym(2011, 7), End: ym(2011, 12)}}),
+			Entry("a role without a start hides earlier gaps", []linkedinscraper.Experience{
+				role("A", ym(2005, 1), ym(2006, 12)),
+				role("B", ym(2010, 1), ym(2012, 3)),
+				role("Unknown", nil, ym(2012, 12)),
+				role("C", ym(2013, 1), nil),
+			}, nil),
+			Entry("undated roles are ignored", []linkedinscraper.Experience{
+				role("A", ym(2010, 1), ym(2010, 12)),
+				role("Undated", nil, nil),
+				role("B", ym(2012, 1), nil),
+			}, []linkedinscraper.DateRange{{Start: ym(2011, 1), End: ym(2011, 12)}}),
+			Entry("no experience", nil, nil),
+		)
+	})
+
+	Describe("CompletenessScore", func() {
+		It("scores a sparse profile low", func() {
+			profile := &linkedinscraper.LinkedInProfile{
+				FullName:       "Jane Doe",
+				Headline:       "Investor",
+				ProfilePicture: &linkedinscraper.ProfilePicture{A11yText: "Jane Doe"},
+			}
+			Expect(profile.CompletenessScore()).To(Equal(15))
+		})
+
+		It("scores the rich fixture profile high", func() {
+			profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile.json"))
+			Expect(err).NotTo(HaveOccurred())
+			profile.Summary = "Early-stage investor."
+
+			Expect(profile.CompletenessScore()).To(Equal(100))
+		})
+
+		It("is zero for an empty profile", func() {
+			Expect((&linkedinscraper.LinkedInProfile{}).CompletenessScore()).To(BeZero())
+		})
+	})
+
+	Describe("CompactJSON", func() {
+		It("drops empty nested objects and arrays", func() {
+			profile := &linkedinscraper.LinkedInProfile{
+				FullName:       "Jane Doe",
+				ConnectionInfo: &linkedinscraper.ConnectionInfo{},
+				Experience: []linkedinscraper.Experience{
+					{CompanyName: "Acme", DateRange: &linkedinscraper.DateRange{}},
+				},
+				Interests: &linkedinscraper.Interests{Hashtags: []string{}},
+				ProfilePicture: &linkedinscraper.ProfilePicture{
+					ExpiresAt: 1861920000000,
+				},
+			}
+
+			data, err := profile.CompactJSON()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(MatchJSON(`{
+				"fullName": "Jane Doe",
+				"experience": [{"companyName": "Acme"}],
+				"profilePicture": {"expiresAt": 1861920000000}
+			}`))
+		})
+
+		It("keeps everything a parsed profile carries", func() {
+			profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile.json"))
+			Expect(err).NotTo(HaveOccurred())
+
+			data, err := profile.CompactJSON()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(ContainSubstring(`"publicIdentifier":"jane-doe"`))
+			Expect(string(data)).NotTo(ContainSubstring("{}"))
+			Expect(string(data)).NotTo(ContainSubstring("[]"))
+		})
+	})
+})
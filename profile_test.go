@@ -0,0 +1,889 @@
+package linkedinscraper_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("ParseFromJSON", func() {
+	DescribeTable("matches the output recorded before included entities were indexed by $type",
+		func(fixture, golden string) {
+			profile, err := linkedinscraper.ParseFromJSON(loadFixture(fixture))
+			Expect(err).NotTo(HaveOccurred())
+
+			actual, err := json.Marshal(profile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(MatchJSON(loadFixture(golden)))
+		},
+		Entry("base profile", "profile.json", "profile.golden.json"),
+		Entry("research profile", "profile_research.json", "profile_research.golden.json"),
+	)
+
+	It("parses the base profile fixture", func() {
+		profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile.json"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profile.PublicIdentifier).To(Equal("jane-doe"))
+		Expect(profile.FullName).To(Equal("Jane Doe"))
+		Expect(profile.Experience).To(HaveLen(2))
+		Expect(profile.Education).To(HaveLen(1))
+		Expect(profile.Skills).To(HaveLen(2))
+	})
+
+	It("carries the earliest picture artifact expiry", func() {
+		profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile.json"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profile.ProfilePicture).NotTo(BeNil())
+		Expect(profile.ProfilePicture.DisplayImageUrn).To(Equal("urn:li:digitalmediaAsset:C4D03AQJaneDoe"))
+		Expect(profile.ProfilePicture.RootURL).To(HavePrefix("https://media.licdn.com/"))
+		Expect(profile.ProfilePicture.ExpiresAt).To(Equal(int64(1861920000000)))
+	})
+
+	It("falls back to the miniProfile shape when no full profile is present", func() {
+		profile, err := linkedinscraper.ParseFromJSON(loadFixture("miniprofile.json"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.PublicIdentifier).To(Equal("jane-doe"))
+		Expect(profile.Headline).To(Equal("Staff Engineer at Acme | Distributed systems"))
+	})
+
+	It("captures the industry URN", func() {
+		profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile.json"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profile.IndustryURN).To(Equal("urn:li:fsd_industry:43"))
+		Expect(profile.Industry).To(BeEmpty())
+	})
+
+	It("parses patents and publications", func() {
+		profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile_research.json"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profile.Patents).To(HaveLen(1))
+		Expect(profile.Patents[0]).To(MatchFields(IgnoreExtras, Fields{
+			"Title":  Equal("Method for distributed consensus"),
+			"Number": Equal("US 10,123,456 B2"),
+			"Issuer": Equal("United States Patent and Trademark Office"),
+			"Date":   Equal(&linkedinscraper.Date{Year: 2019, Month: 11, Day: 12}),
+			"URL":    Equal("https://patents.google.com/patent/US10123456B2"),
+		}))
+
+		Expect(profile.Publications).To(HaveLen(2))
+		Expect(profile.Publications[0].Title).To(Equal("Scaling Byzantine Agreement"))
+		Expect(profile.Publications[0].Publisher).To(Equal("ACM SIGCOMM"))
+		Expect(profile.Publications[0].Date).To(Equal(&linkedinscraper.Date{Year: 2021, Month: 8}))
+		Expect(profile.Publications[0].Description).To(Equal("A protocol for large validator sets."))
+		Expect(profile.Publications[1].Title).To(Equal("Gossip Under Churn"))
+		Expect(profile.Publications[1].URL).To(BeEmpty())
+	})
+
+	It("parses the education grade and activities", func() {
+		profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile_education.json"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profile.Education).To(HaveLen(1))
+		Expect(profile.Education[0]).To(MatchFields(IgnoreExtras, Fields{
+			"SchoolName":   Equal("Stanford University"),
+			"DegreeName":   Equal("Bachelor of Science"),
+			"FieldOfStudy": Equal("Economics"),
+			"Grade":        Equal("3.9 GPA"),
+			"Activities":   Equal("Varsity Rowing, Stanford Investment Club, Economics Honor Society"),
+		}))
+	})
+
+	It("parses followed interests by type", func() {
+		profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile_interests.json"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profile.Interests).To(Equal(&linkedinscraper.Interests{
+			Companies:   []string{"Sequoia Capital", "Stripe"},
+			Influencers: []string{"Reid Hoffman"},
+			Hashtags:    []string{"venturecapital", "fintech"},
+			Schools:     []string{"HEC Paris"},
+		}))
+	})
+
+	It("captures the occupation separately from the headline", func() {
+		profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile_occupation.json"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profile.Headline).To(Equal("Helping founders raise their first round | Angel investor"))
+		Expect(profile.Occupation).To(Equal("Partner at Acme Capital"))
+	})
+
+	It("flags a profile shown with a localized private-mode placeholder", func() {
+		profile, err := linkedinscraper.ParseFromJSON(profileResponseJSON(profileEntity("private-member", "LinkedIn-", "Mitglied")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.IsPrivate).To(BeTrue())
+		Expect(profile.FullName).To(BeEmpty())
+		Expect(profile.LastName).To(BeEmpty())
+
+		profile, err = linkedinscraper.ParseFromJSON(profileResponseJSON(profileEntity("private-member", "Membre", "de LinkedIn")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.IsPrivate).To(BeTrue())
+
+		profile, err = linkedinscraper.ParseFromJSON(profileResponseJSON(profileEntity("private-member", "LinkedIn", "Member")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.IsPrivate).To(BeTrue())
+		Expect(profile.FullName).To(BeEmpty())
+		Expect(profile.FirstName).To(BeEmpty())
+	})
+
+	It("keeps names that only resemble a placeholder", func() {
+		profile, err := linkedinscraper.ParseFromJSON(profileResponseJSON(profileEntity("linkedin-lidia", "Linkedin", "Lidia")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.IsPrivate).To(BeFalse())
+		Expect(profile.FirstName).To(Equal("Linkedin"))
+	})
+
+	It("parses the services a freelancer offers", func() {
+		profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile_services.json"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profile.Services).To(Equal([]string{"Web Development", "Consulting"}))
+	})
+
+	It("collects every active open-to state", func() {
+		profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile_open_to.json"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profile.OpenTo).To(Equal([]string{linkedinscraper.OpenToMentoring, linkedinscraper.OpenToInvesting}))
+		Expect(profile.IsOpenToWork()).To(BeFalse())
+		Expect(profile.TempStatus).To(Equal("Open to mentoring"))
+		Expect(profile.TempStatusEmoji).To(Equal("🌱"))
+	})
+
+	It("derives open-to states from the photo frame, status and services", func() {
+		entity := profileEntity("jane-doe", "Jane", "Doe")
+		entity["tempStatus"] = "#OpenToWork"
+		entity["profilePicture"] = map[string]interface{}{"frameType": "HIRING"}
+		profile, err := linkedinscraper.ParseFromJSON(profileResponseJSON(entity))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.OpenTo).To(Equal([]string{linkedinscraper.OpenToHiring, linkedinscraper.OpenToWork}))
+		Expect(profile.IsOpenToWork()).To(BeTrue())
+
+		profile, err = linkedinscraper.ParseFromJSON(loadFixture("profile_services.json"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.OpenTo).To(Equal([]string{linkedinscraper.OpenToServices}))
+	})
+
+	It("leaves services empty without a services page", func() {
+		profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile.json"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profile.Services).To(BeNil())
+	})
+
+	It("parses the top-card skills apart from the skills section", func() {
+		client := newTestClient(newFakeTransport(http.StatusOK, loadFixture("profile_top_skills.json")), func(cfg *linkedinscraper.Config) {
+			cfg.StrictUnknownTypes = true
+		})
+
+		result, err := client.GetProfileVerbose(context.Background(), "jane-doe")
+		Expect(err).NotTo(HaveOccurred())
+		profile := result.Profile
+
+		Expect(profile.TopSkills).To(Equal([]string{"Due Diligence", "Fundraising", "Venture Capital"}))
+		var names []string
+		for _, skill := range profile.Skills {
+			names = append(names, skill.Name)
+		}
+		Expect(names).To(Equal([]string{"Venture Capital", "Due Diligence", "Financial Modeling", "Public Speaking"}))
+		Expect(result.Stats.Skills).To(Equal(linkedinscraper.SectionStats{Seen: 4, Parsed: 4}))
+	})
+
+	It("ignores top-card skills of other members", func() {
+		otherTopSkills := map[string]interface{}{
+			"$type":     linkedinscraper.EntityTypeTopSkills,
+			"entityUrn": "urn:li:fsd_profileTopSkills:ACoAAAsomeone-else",
+			"topSkills": []map[string]interface{}{{"name": "Sales"}},
+		}
+		profile, err := linkedinscraper.ParseFromJSON(profileResponseJSON(profileEntity("jane-doe", "Jane", "Doe"), otherTopSkills))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.TopSkills).To(BeEmpty())
+	})
+
+	It("captures the top-card display location", func() {
+		profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile.json"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profile.DisplayLocation).To(Equal("Greater Paris Metropolitan Region"))
+		Expect(profile.Location).To(Equal(profile.DisplayLocation))
+	})
+
+	It("falls back to the plain locationName text", func() {
+		entity := profileEntity("someone", "Jane", "Doe")
+		entity["locationName"] = "Greater Seattle Area"
+		profile, err := linkedinscraper.ParseFromJSON(profileResponseJSON(entity))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profile.DisplayLocation).To(Equal("Greater Seattle Area"))
+	})
+})
+
+var _ = Describe("GetProfile", func() {
+	Describe("FullName assembly", func() {
+		DescribeTable("orders name parts by format and locale",
+			func(nameFormat, language, firstName, lastName, expected string) {
+				entity := profileEntity("someone", firstName, lastName)
+				if language != "" {
+					entity["primaryLocale"] = map[string]interface{}{"language": language}
+				}
+				client := newTestClient(newFakeTransport(http.StatusOK, profileResponseJSON(entity)), func(cfg *linkedinscraper.Config) {
+					cfg.NameFormat = nameFormat
+				})
+
+				profile, err := client.GetProfile(context.Background(), "someone")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(profile.FullName).To(Equal(expected))
+			},
+			Entry("Western order by default", "", "en", "Jane", "Doe", "Jane Doe"),
+			Entry("Eastern order derived from locale", "", "ja", "Taro", "Yamada", "Yamada Taro"),
+			Entry("Eastern order from config", linkedinscraper.NameFormatFamilyGiven, "", "Taro", "Yamada", "Yamada Taro"),
+			Entry("config overrides locale", linkedinscraper.NameFormatGivenFamily, "ja", "Taro", "Yamada", "Taro Yamada"),
+			Entry("single name without last name", "", "", "Cher", "", "Cher"),
+			Entry("single name without first name", linkedinscraper.NameFormatFamilyGiven, "", "", "Suharto", "Suharto"),
+			Entry("surrounding whitespace", "", "", " Jane ", " Doe ", "Jane Doe"),
+		)
+	})
+
+	Describe("GetProfileByMemberID", func() {
+		It("requests the member identity and parses the matching profile", func() {
+			entity := profileEntity("jane-doe", "Jane", "Doe")
+			entity["objectUrn"] = "urn:li:member:123456"
+			transport := newFakeTransport(http.StatusOK, profileResponseJSON(entity))
+
+			profile, err := newTestClient(transport).GetProfileByMemberID(context.Background(), 123456)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(transport.Requests()[0].URL.RawQuery).To(ContainSubstring("variables=(memberIdentity:urn%3Ali%3Amember%3A123456)"))
+			Expect(profile.PublicIdentifier).To(Equal("jane-doe"))
+			Expect(profile.FullName).To(Equal("Jane Doe"))
+		})
+
+		It("rejects non-positive IDs without a request", func() {
+			transport := newFakeTransport(http.StatusOK, profileResponseJSON())
+			_, err := newTestClient(transport).GetProfileByMemberID(context.Background(), 0)
+			Expect(err).To(HaveOccurred())
+			Expect(transport.Requests()).To(BeEmpty())
+		})
+	})
+
+	Describe("GetProfileLite", func() {
+		It("parses the top card without web metadata and leaves sections empty", func() {
+			transport := newFakeTransport(http.StatusOK, loadFixture("profile.json"))
+
+			profile, err := newTestClient(transport).GetProfileLite(context.Background(), "jane-doe")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(transport.Requests()).To(HaveLen(1))
+			Expect(transport.Requests()[0].URL.Query().Get("queryId")).To(Equal(linkedinscraper.DefaultProfileTopCardQueryID))
+			Expect(transport.Requests()[0].URL.Query().Get("includeWebMetadata")).To(Equal("false"))
+			Expect(profile.FullName).To(Equal("Jane Doe"))
+			Expect(profile.Headline).To(Equal("Partner at Acme Capital"))
+			Expect(profile.CurrentCompany).To(Equal("Acme Capital"))
+			Expect(profile.FlatMap()).To(HaveKeyWithValue("current_company", "Acme Capital"))
+			Expect(profile.Experience).To(BeEmpty())
+			Expect(profile.Education).To(BeEmpty())
+			Expect(profile.Skills).To(BeEmpty())
+		})
+
+		It("falls back to the full profile query when the top-card query is rejected", func() {
+			transport := &fakeTransport{handler: func(req *http.Request) *http.Response {
+				if req.URL.Query().Get("queryId") == linkedinscraper.DefaultProfileTopCardQueryID {
+					return newResponse(http.StatusBadRequest, []byte(`{"message":"PersistedQueryNotFound"}`))
+				}
+				return newResponse(http.StatusOK, loadFixture("profile.json"))
+			}}
+
+			profile, err := newTestClient(transport).GetProfileLite(context.Background(), "jane-doe")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.CurrentCompany).To(Equal("Acme Capital"))
+			Expect(transport.Requests()).To(HaveLen(2))
+			Expect(transport.Requests()[1].URL.Query().Get("queryId")).To(Equal(linkedinscraper.DefaultProfileQueryID))
+		})
+
+		It("sets the same CurrentCompany as a full profile", func() {
+			profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile.json"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.CurrentCompany).To(Equal("Acme Capital"))
+		})
+	})
+
+	Describe("GetProfileLocalized", func() {
+		It("returns the requested language variant of the same profile", func() {
+			transport := newFakeTransport(http.StatusOK, loadFixture("profile_localized.json"))
+			client := newTestClient(transport)
+
+			english, err := client.GetProfileLocalized(context.Background(), "kenji-tanaka", "en_US")
+			Expect(err).NotTo(HaveOccurred())
+			japanese, err := client.GetProfileLocalized(context.Background(), "kenji-tanaka", "ja-JP")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(english.Locale).To(Equal("en_US"))
+			Expect(english.FullName).To(Equal("Kenji Tanaka"))
+			Expect(english.Headline).To(Equal("Product Manager at Example KK"))
+			Expect(english.Summary).To(Equal("Building payments products for the Japanese market."))
+
+			Expect(japanese.Locale).To(Equal("ja_JP"))
+			Expect(japanese.FirstName).To(Equal("健二"))
+			Expect(japanese.LastName).To(Equal("田中"))
+			Expect(japanese.FullName).To(Equal("田中 健二"))
+			Expect(japanese.Headline).To(Equal("Example株式会社 プロダクトマネージャー"))
+			Expect(japanese.Summary).To(Equal("日本市場向けの決済プロダクトを開発しています。"))
+			Expect(japanese.URN).To(Equal(english.URN))
+
+			requests := transport.Requests()
+			Expect(requests[0].Header.Values("X-Li-Lang")).To(Equal([]string{"en_US"}))
+			Expect(requests[1].Header.Values("X-Li-Lang")).To(Equal([]string{"ja_JP"}))
+			Expect(requests[1].Header.Get("Accept-Language")).To(Equal("ja-JP,ja;q=0.9,en;q=0.8"))
+		})
+
+		It("keeps the default version for languages the member did not write", func() {
+			transport := newFakeTransport(http.StatusOK, loadFixture("profile_localized.json"))
+
+			profile, err := newTestClient(transport).GetProfileLocalized(context.Background(), "kenji-tanaka", "fr_FR")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Locale).To(Equal("fr_FR"))
+			Expect(profile.FullName).To(Equal("Kenji Tanaka"))
+			Expect(profile.Headline).To(Equal("Product Manager at Example KK"))
+		})
+
+		It("requires a locale", func() {
+			transport := newFakeTransport(http.StatusOK, loadFixture("profile_localized.json"))
+
+			_, err := newTestClient(transport).GetProfileLocalized(context.Background(), "kenji-tanaka", "")
+			Expect(err).To(HaveOccurred())
+			Expect(transport.Requests()).To(BeEmpty())
+		})
+	})
+
+	Describe("GetProfiles", func() {
+		ids := []string{"jane-doe", "broken", "john-roe", "ada-poe", "max-moe"}
+
+		// profilesTransport serves a profile per identifier and a 401 for "broken".
+		profilesTransport := func() *fakeTransport {
+			return &fakeTransport{handler: func(req *http.Request) *http.Response {
+				for _, id := range ids {
+					if strings.Contains(req.URL.RawQuery, "vanityName:"+id+")") {
+						if id == "broken" {
+							return newResponse(http.StatusUnauthorized, nil)
+						}
+						return newResponse(http.StatusOK, profileResponseJSON(profileEntity(id, "Test", "Member")))
+					}
+				}
+				return newResponse(http.StatusNotFound, nil)
+			}}
+		}
+
+		It("collects every error by default", func() {
+			transport := profilesTransport()
+
+			profiles, err := newTestClient(transport).GetProfiles(context.Background(), ids, linkedinscraper.GetProfilesOptions{Concurrency: 2})
+			Expect(err).To(MatchError(linkedinscraper.ErrUnauthorized))
+			Expect(err.Error()).To(ContainSubstring("broken"))
+			Expect(profiles).To(HaveLen(4))
+			Expect(profiles[1].PublicIdentifier).To(Equal("john-roe"))
+			Expect(transport.Requests()).To(HaveLen(5))
+		})
+
+		It("stops at the first error with StopOnError", func() {
+			transport := profilesTransport()
+
+			profiles, err := newTestClient(transport).GetProfiles(context.Background(), ids, linkedinscraper.GetProfilesOptions{
+				Concurrency: 1,
+				StopOnError: true,
+			})
+			Expect(err).To(MatchError(linkedinscraper.ErrUnauthorized))
+			Expect(profiles).To(HaveLen(1))
+			Expect(profiles[0].PublicIdentifier).To(Equal("jane-doe"))
+			Expect(transport.Requests()).To(HaveLen(2))
+		})
+
+		It("skips 404s but still reports other failures with Skip404", func() {
+			statuses := map[string]int{"jane-doe": http.StatusOK, "deleted": http.StatusNotFound, "busy": http.StatusTooManyRequests, "john-roe": http.StatusOK}
+			transport := &fakeTransport{handler: func(req *http.Request) *http.Response {
+				for id, status := range statuses {
+					if strings.Contains(req.URL.RawQuery, "vanityName:"+id+")") {
+						if status != http.StatusOK {
+							return newResponse(status, nil)
+						}
+						return newResponse(http.StatusOK, profileResponseJSON(profileEntity(id, "Test", "Member")))
+					}
+				}
+				return newResponse(http.StatusBadRequest, nil)
+			}}
+
+			profiles, err := newTestClient(transport).GetProfiles(context.Background(), []string{"jane-doe", "deleted", "busy", "john-roe"}, linkedinscraper.GetProfilesOptions{Skip404: true})
+			Expect(err).To(MatchError(linkedinscraper.ErrRateLimited))
+			Expect(err).NotTo(MatchError(linkedinscraper.ErrNotFound))
+			Expect(err.Error()).NotTo(ContainSubstring("deleted"))
+			Expect(profiles).To(HaveLen(4))
+			Expect(profiles[0].PublicIdentifier).To(Equal("jane-doe"))
+			Expect(profiles[1]).To(BeNil())
+			Expect(profiles[2]).To(BeNil())
+			Expect(profiles[3].PublicIdentifier).To(Equal("john-roe"))
+		})
+
+		It("backs off concurrency on 429s and still completes the batch", func() {
+			const threshold = 2
+			var inFlight, rateLimited atomic.Int32
+			transport := &fakeTransport{handler: func(req *http.Request) *http.Response {
+				defer inFlight.Add(-1)
+				if inFlight.Add(1) > threshold {
+					rateLimited.Add(1)
+					return newResponse(http.StatusTooManyRequests, nil)
+				}
+				time.Sleep(5 * time.Millisecond)
+				return newResponse(http.StatusOK, profileResponseJSON(profileEntity("jane-doe", "Jane", "Doe")))
+			}}
+			client := newTestClient(transport, func(cfg *linkedinscraper.Config) {
+				cfg.RetryBackoff = time.Millisecond
+			})
+
+			var mu sync.Mutex
+			var limits []int
+			batch := make([]string, 24)
+			for i := range batch {
+				batch[i] = "jane-doe"
+			}
+			profiles, err := client.GetProfiles(context.Background(), batch, linkedinscraper.GetProfilesOptions{
+				Concurrency:      8,
+				RateLimitRetries: 20,
+				OnConcurrencyChange: func(concurrency int) {
+					mu.Lock()
+					defer mu.Unlock()
+					limits = append(limits, concurrency)
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profiles).To(HaveLen(len(batch)))
+
+			Expect(rateLimited.Load()).To(BeNumerically(">", 0))
+			mu.Lock()
+			defer mu.Unlock()
+			Expect(limits).NotTo(BeEmpty())
+			Expect(limits[0]).To(Equal(4))
+			Expect(slices.Min(limits)).To(BeNumerically("<=", threshold))
+			Expect(slices.Min(limits)).To(BeNumerically(">=", 1))
+		})
+
+		It("reports 429s right away without RateLimitRetries", func() {
+			transport := newFakeTransport(http.StatusTooManyRequests, nil)
+
+			var limits []int
+			_, err := newTestClient(transport).GetProfiles(context.Background(), []string{"busy"}, linkedinscraper.GetProfilesOptions{
+				OnConcurrencyChange: func(concurrency int) { limits = append(limits, concurrency) },
+			})
+			Expect(err).To(MatchError(linkedinscraper.ErrRateLimited))
+			Expect(transport.Requests()).To(HaveLen(1))
+			Expect(limits).To(BeEmpty()) // A single worker has nowhere to back off to
+		})
+
+		It("reports 404s by default", func() {
+			transport := newFakeTransport(http.StatusNotFound, nil)
+
+			_, err := newTestClient(transport).GetProfiles(context.Background(), []string{"deleted"}, linkedinscraper.GetProfilesOptions{})
+			Expect(err).To(MatchError(linkedinscraper.ErrNotFound))
+			Expect(err).To(MatchError(linkedinscraper.ErrRequestFailed))
+		})
+	})
+
+	Describe("BatchGetProfilesByURN", func() {
+		It("fetches a batch in one request and returns profiles in input order", func() {
+			transport := newFakeTransport(http.StatusOK, loadFixture("profile_batch.json"))
+			urns := []string{"urn:li:member:1", "urn:li:fsd_profile:ACoAAAJohnRoe", "urn:li:member:3"}
+
+			profiles, err := newTestClient(transport).BatchGetProfilesByURN(context.Background(), urns)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(transport.Requests()).To(HaveLen(1))
+			Expect(transport.Requests()[0].URL.RawQuery).To(ContainSubstring(
+				"variables=(memberIdentities:List(urn%3Ali%3Amember%3A1,urn%3Ali%3Afsd_profile%3AACoAAAJohnRoe,urn%3Ali%3Amember%3A3))"))
+
+			Expect(profiles).To(HaveLen(3))
+			Expect(profiles[0].PublicIdentifier).To(Equal("jane-doe"))
+			Expect(profiles[1].PublicIdentifier).To(Equal("john-roe"))
+			Expect(profiles[2].PublicIdentifier).To(Equal("ada-poe"))
+		})
+
+		It("attributes section entities to their own profile", func() {
+			transport := newFakeTransport(http.StatusOK, loadFixture("profile_batch.json"))
+			urns := []string{"urn:li:member:1", "urn:li:member:2", "urn:li:member:3"}
+
+			profiles, err := newTestClient(transport).BatchGetProfilesByURN(context.Background(), urns)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(profiles[0].Experience).To(HaveLen(1))
+			Expect(profiles[0].Experience[0].CompanyName).To(Equal("Acme Capital"))
+			Expect(profiles[1].Experience).To(HaveLen(2))
+			Expect(profiles[2].Experience).To(BeEmpty())
+		})
+
+		It("chunks inputs larger than MaxProfileBatchSize", func() {
+			transport := newFakeTransport(http.StatusOK, loadFixture("profile_batch.json"))
+			urns := make([]string, linkedinscraper.MaxProfileBatchSize+1)
+			for i := range urns {
+				urns[i] = fmt.Sprintf("urn:li:member:%d", i+100)
+			}
+
+			profiles, err := newTestClient(transport).BatchGetProfilesByURN(context.Background(), urns)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profiles).To(BeEmpty())
+			Expect(transport.Requests()).To(HaveLen(2))
+		})
+	})
+
+	Describe("responses without a public identifier", func() {
+		It("falls back to the primary profile entity and adopts the requested identifier", func() {
+			client := newTestClient(newFakeTransport(http.StatusOK, loadFixture("profile_no_public_id.json")))
+
+			profile, err := client.GetProfile(context.Background(), "jane-doe")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(profile.PublicIdentifier).To(Equal("jane-doe"))
+			Expect(profile.URN).To(Equal("urn:li:fsd_profile:ACoAAAJaneDoe"))
+			Expect(profile.ProfileURL).To(Equal("https://www.linkedin.com/in/jane-doe/"))
+			Expect(profile.FullName).To(Equal("Jane Doe"))
+			Expect(profile.Experience).To(HaveLen(1))
+		})
+
+		It("does not adopt a profile entity carrying a different identifier", func() {
+			client := newTestClient(newFakeTransport(http.StatusOK, profileResponseJSON(profileEntity("john-roe", "John", "Roe"))))
+
+			_, err := client.GetProfile(context.Background(), "jane-doe")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("StrictUnknownTypes", func() {
+		It("fails listing the unrecognised profile types", func() {
+			client := newTestClient(newFakeTransport(http.StatusOK, loadFixture("profile_unknown_type.json")), func(cfg *linkedinscraper.Config) {
+				cfg.StrictUnknownTypes = true
+			})
+
+			_, err := client.GetProfile(context.Background(), "jane-doe")
+			Expect(err).To(MatchError(linkedinscraper.ErrUnknownEntityType))
+			Expect(err.Error()).To(ContainSubstring("com.linkedin.voyager.dash.identity.profile.VolunteerExperience"))
+			Expect(err.Error()).NotTo(ContainSubstring("Industry"))
+		})
+
+		It("ignores them by default", func() {
+			client := newTestClient(newFakeTransport(http.StatusOK, loadFixture("profile_unknown_type.json")))
+
+			profile, err := client.GetProfile(context.Background(), "jane-doe")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Experience).To(HaveLen(1))
+		})
+
+		It("accepts responses with only known types", func() {
+			client := newTestClient(newFakeTransport(http.StatusOK, loadFixture("profile.json")), func(cfg *linkedinscraper.Config) {
+				cfg.StrictUnknownTypes = true
+			})
+
+			_, err := client.GetProfile(context.Background(), "jane-doe")
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("CleanDescriptions", func() {
+		getProfile := func(clean bool) *linkedinscraper.LinkedInProfile {
+			client := newTestClient(newFakeTransport(http.StatusOK, loadFixture("profile_raw_descriptions.json")), func(cfg *linkedinscraper.Config) {
+				cfg.CleanDescriptions = clean
+			})
+			profile, err := client.GetProfile(context.Background(), "jane-doe")
+			Expect(err).NotTo(HaveOccurred())
+			return profile
+		}
+
+		It("keeps descriptions as returned by default", func() {
+			profile := getProfile(false)
+
+			Expect(profile.Experience[0].Description).To(Equal(
+				"Led the <b>growth</b> team &amp; hired 12 people.<br/>Raised **$40M** Series B.\\n\\n\\n  Board    observer at   3 portfolio companies.  "))
+			Expect(profile.Education[0].Description).To(Equal(
+				"\u200bExchange semester\u00a0in Tokyo.\r\n\r\n\r\n\tThesis on __venture debt__."))
+		})
+
+		It("strips formatting and normalizes whitespace, keeping line breaks", func() {
+			profile := getProfile(true)
+
+			Expect(profile.Experience[0].Description).To(Equal(
+				"Led the growth team & hired 12 people.\nRaised $40M Series B.\n\nBoard observer at 3 portfolio companies."))
+			Expect(profile.Education[0].Description).To(Equal(
+				"Exchange semester in Tokyo.\n\nThesis on venture debt."))
+		})
+
+		DescribeTable("only removes markdown-delimited emphasis and double-escaping",
+			func(description, expected string) {
+				Expect(linkedinscraper.CleanDescription(description)).To(Equal(expected))
+			},
+			Entry("bold spans", "Raised **$40M** and __grew__ revenue", "Raised $40M and grew revenue"),
+			Entry("identifiers with double underscores", "Maintained my__module__name and snake__case", "Maintained my__module__name and snake__case"),
+			Entry("unpaired markers", "Computed 2**10 and a ** rating", "Computed 2**10 and a ** rating"),
+			Entry("markers across lines", "**Started\nthere**", "**Started\nthere**"),
+			Entry("a double-escaped description", `Line one\nLine two\tend`, "Line one\nLine two end"),
+			Entry("escape-like text in a multi-line description", "Moved files to C:\\new\nDone", "Moved files to C:\\new\nDone"),
+		)
+	})
+
+	Describe("section ordering", func() {
+		It("orders sections deterministically across repeated parses", func() {
+			fixture := loadFixture("profile_long_history.json")
+			first, err := linkedinscraper.ParseFromJSON(fixture)
+			Expect(err).NotTo(HaveOccurred())
+
+			var companies, schools, skills []string
+			for _, e := range first.Experience {
+				companies = append(companies, e.CompanyName)
+			}
+			for _, e := range first.Education {
+				schools = append(schools, e.SchoolName)
+			}
+			for _, s := range first.Skills {
+				skills = append(skills, s.Name)
+			}
+			Expect(companies).To(Equal([]string{"Acme Capital", "Board Seat Inc", "Growth Fund", "Former Corp", "Early Startup"}))
+			Expect(schools).To(Equal([]string{"HEC Paris", "Sciences Po", "Lycee Henri IV"}))
+			Expect(skills).To(Equal([]string{"Venture Capital", "Due Diligence", "Board Governance", "Fundraising"}))
+
+			for range 20 {
+				again, err := linkedinscraper.ParseFromJSON(fixture)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(again).To(Equal(first))
+			}
+		})
+	})
+
+	Describe("MaxExperienceEntries and MaxEducationEntries", func() {
+		It("keeps only the most recent entries", func() {
+			client := newTestClient(newFakeTransport(http.StatusOK, loadFixture("profile_long_history.json")), func(cfg *linkedinscraper.Config) {
+				cfg.MaxExperienceEntries = 2
+				cfg.MaxEducationEntries = 1
+			})
+
+			profile, err := client.GetProfile(context.Background(), "jane-doe")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(profile.Experience).To(HaveLen(2))
+			Expect(profile.Experience[0].CompanyName).To(Equal("Acme Capital"))
+			Expect(profile.Experience[1].CompanyName).To(Equal("Board Seat Inc"))
+			Expect(profile.Education).To(HaveLen(1))
+			Expect(profile.Education[0].SchoolName).To(Equal("HEC Paris"))
+		})
+
+		It("keeps every entry by default", func() {
+			client := newTestClient(newFakeTransport(http.StatusOK, loadFixture("profile_long_history.json")))
+
+			profile, err := client.GetProfile(context.Background(), "jane-doe")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(profile.Experience).To(HaveLen(5))
+			Expect(profile.Education).To(HaveLen(3))
+		})
+	})
+
+	Describe("viewer relationship", func() {
+		It("parses a 2nd-degree connection with 7 mutuals", func() {
+			profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile_relationship.json"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(profile.NetworkDistance).To(Equal(linkedinscraper.NetworkSecondDegree))
+			Expect(profile.MutualConnectionsCount).To(Equal(7))
+		})
+
+		It("leaves both empty without a relationship entity", func() {
+			profile, err := linkedinscraper.ParseFromJSON(loadFixture("profile.json"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(profile.NetworkDistance).To(BeEmpty())
+			Expect(profile.MutualConnectionsCount).To(BeZero())
+		})
+	})
+
+	Describe("restricted profiles", func() {
+		It("returns ErrProfileRestricted when the profile entity is withheld", func() {
+			client := newTestClient(newFakeTransport(http.StatusOK, loadFixture("profile_restricted.json")))
+
+			profile, err := client.GetProfile(context.Background(), "restricted-person")
+			Expect(err).To(MatchError(linkedinscraper.ErrProfileRestricted))
+			Expect(profile).To(BeNil())
+		})
+
+		It("keeps the not-found error when no profile is referenced", func() {
+			client := newTestClient(newFakeTransport(http.StatusOK, profileResponseJSON()))
+
+			_, err := client.GetProfile(context.Background(), "missing-person")
+			Expect(err).To(HaveOccurred())
+			Expect(err).NotTo(MatchError(linkedinscraper.ErrProfileRestricted))
+		})
+	})
+
+	Describe("empty responses", func() {
+		It("returns ErrEmptyResponse when included is null", func() {
+			client := newTestClient(newFakeTransport(http.StatusOK, loadFixture("profile_empty.json")))
+
+			profile, err := client.GetProfile(context.Background(), "jane-doe")
+			Expect(err).To(MatchError(linkedinscraper.ErrEmptyResponse))
+			Expect(profile).To(BeNil())
+		})
+
+		It("returns ErrEmptyResponse for member lookups", func() {
+			client := newTestClient(newFakeTransport(http.StatusOK, loadFixture("profile_empty.json")))
+
+			_, err := client.GetProfileByMemberID(context.Background(), 123456)
+			Expect(err).To(MatchError(linkedinscraper.ErrEmptyResponse))
+		})
+
+		It("keeps the not-found error when other entities are present", func() {
+			client := newTestClient(newFakeTransport(http.StatusOK, profileResponseJSON(profileEntity("someone-else", "John", "Roe"))))
+
+			_, err := client.GetProfile(context.Background(), "jane-doe")
+			Expect(err).To(HaveOccurred())
+			Expect(err).NotTo(MatchError(linkedinscraper.ErrEmptyResponse))
+		})
+	})
+
+	Describe("GetProfileVerbose", func() {
+		It("reports parse coverage per section and logs it at debug level", func() {
+			var logs bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+			cfg, err := linkedinscraper.NewConfig(linkedinscraper.AuthCredentials{LiAtCookie: "test-li-at", CSRFToken: "ajax:test-csrf"})
+			Expect(err).NotTo(HaveOccurred())
+			client, err := linkedinscraper.NewClient(cfg,
+				linkedinscraper.WithHTTPClient(&http.Client{Transport: newFakeTransport(http.StatusOK, loadFixture("profile.json"))}),
+				linkedinscraper.WithLogger(logger),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := client.GetProfileVerbose(context.Background(), "jane-doe")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Profile.FullName).To(Equal("Jane Doe"))
+			Expect(result.Stats).To(Equal(linkedinscraper.ParseStats{
+				Experience:     linkedinscraper.SectionStats{Seen: 2, Parsed: 2},
+				Education:      linkedinscraper.SectionStats{Seen: 1, Parsed: 1},
+				Skills:         linkedinscraper.SectionStats{Seen: 2, Parsed: 2},
+				Certifications: linkedinscraper.SectionStats{Seen: 1, Parsed: 1},
+			}))
+			Expect(result.Profile.Certifications[0].Authority).To(Equal("CFA Institute"))
+			Expect(logs.String()).To(ContainSubstring("profile parse coverage"))
+			Expect(logs.String()).To(ContainSubstring("experienceSeen=2 experienceParsed=2"))
+		})
+
+		It("shows unparsed entities when a $type drifts", func() {
+			drifted := map[string]interface{}{
+				"$type":       "com.linkedin.voyager.dash.identity.profile.PositionV2",
+				"entityUrn":   "urn:li:fsd_profilePosition:(ACoAAAsomeone,1)",
+				"companyName": "Acme",
+			}
+			client := newTestClient(newFakeTransport(http.StatusOK, profileResponseJSON(profileEntity("someone", "Jane", "Doe"), drifted)))
+
+			result, err := client.GetProfileVerbose(context.Background(), "someone")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Stats.Experience).To(Equal(linkedinscraper.SectionStats{Seen: 1, Parsed: 0}))
+		})
+	})
+
+	Describe("FetchAllSections", func() {
+		// pagedSectionsTransport serves the paged profile fixture and, for section page
+		// requests, the one position it left out.
+		pagedSectionsTransport := func() *fakeTransport {
+			remaining := profileResponseJSON(map[string]interface{}{
+				"$type":       "com.linkedin.voyager.dash.identity.profile.Position",
+				"entityUrn":   "urn:li:fsd_profilePosition:(ACoAAAJaneDoe,1)",
+				"companyName": "First Job Inc",
+				"title":       "Analyst",
+				"dateRange":   map[string]interface{}{"start": map[string]interface{}{"year": 2014}, "end": map[string]interface{}{"year": 2015}},
+			})
+			return &fakeTransport{handler: func(req *http.Request) *http.Response {
+				if strings.Contains(req.URL.RawQuery, linkedinscraper.DefaultProfileComponentsQueryID) {
+					return newResponse(http.StatusOK, remaining)
+				}
+				return newResponse(http.StatusOK, loadFixture("profile_paged_sections.json"))
+			}}
+		}
+
+		It("reports sections cut short by paging without fetching them by default", func() {
+			transport := pagedSectionsTransport()
+
+			result, err := newTestClient(transport).GetProfileVerbose(context.Background(), "jane-doe")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(transport.Requests()).To(HaveLen(1))
+			Expect(result.Stats.Experience).To(Equal(linkedinscraper.SectionStats{Seen: 2, Parsed: 2, Total: 3}))
+			Expect(result.Stats.Education).To(Equal(linkedinscraper.SectionStats{Seen: 1, Parsed: 1, Total: 1}))
+		})
+
+		It("fetches the remaining experience page", func() {
+			transport := pagedSectionsTransport()
+			client := newTestClient(transport, func(cfg *linkedinscraper.Config) {
+				cfg.FetchAllSections = true
+			})
+
+			result, err := client.GetProfileVerbose(context.Background(), "jane-doe")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(transport.Requests()).To(HaveLen(2))
+			Expect(transport.Requests()[1].URL.RawQuery).To(ContainSubstring(
+				"variables=(profileUrn:urn%3Ali%3Afsd_profile%3AACoAAAJaneDoe,sectionType:experience,start:2,count:20)"))
+			Expect(result.Stats.Experience).To(Equal(linkedinscraper.SectionStats{Seen: 3, Parsed: 3, Total: 3}))
+			Expect(result.Profile.Experience).To(HaveLen(3))
+			Expect(result.Profile.Experience[2].CompanyName).To(Equal("First Job Inc"))
+		})
+	})
+})
+
+var _ = Describe("ParseMiniProfile", func() {
+	It("extracts identity, name, headline and picture from a feed payload", func() {
+		profile, err := linkedinscraper.ParseMiniProfile(loadFixture("miniprofile.json"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profile).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"PublicIdentifier": Equal("jane-doe"),
+			"URN":              Equal("urn:li:fs_miniProfile:ACoAAAjane-doe"),
+			"FirstName":        Equal("Jane"),
+			"LastName":         Equal("Doe"),
+			"FullName":         Equal("Jane Doe"),
+			"Headline":         Equal("Staff Engineer at Acme | Distributed systems"),
+			"ProfileURL":       Equal("https://www.linkedin.com/in/jane-doe/"),
+		})))
+		Expect(profile.ProfilePicture).NotTo(BeNil())
+		Expect(profile.ProfilePicture.RootURL).To(HavePrefix("https://media.licdn.com/"))
+		Expect(profile.ProfilePicture.ExpiresAt).To(Equal(int64(1861920000000)))
+		Expect(profile.Experience).To(BeEmpty())
+	})
+
+	It("accepts a bare MiniProfile entity", func() {
+		profile, err := linkedinscraper.ParseMiniProfile([]byte(`{
+			"$type": "com.linkedin.voyager.identity.shared.MiniProfile",
+			"publicIdentifier": "john-roe",
+			"firstName": "John",
+			"lastName": "Roe",
+			"occupation": "Founder"
+		}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.FullName).To(Equal("John Roe"))
+		Expect(profile.Headline).To(Equal("Founder"))
+		Expect(profile.ProfilePicture).To(BeNil())
+	})
+
+	It("fails when the payload holds no miniProfile", func() {
+		_, err := linkedinscraper.ParseMiniProfile(loadFixture("profile.json"))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,92 @@
+package linkedinscraper
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimitStatus is the most recent rate-limit hint seen in LinkedIn response headers.
+type RateLimitStatus struct {
+	Known      bool          // False until a response carries any rate-limit header
+	Limit      int           // Requests allowed in the current window, 0 if not reported
+	Remaining  int           // Requests left in the current window, 0 if not reported
+	Reset      time.Time     // When the window resets or Retry-After elapses; zero if not reported
+	RetryAfter time.Duration // Value of the last Retry-After header, 0 if not reported
+}
+
+// Header names checked for each value, LinkedIn-specific variants first.
+var (
+	rateLimitLimitHeaders     = []string{"X-Li-Ratelimit-Limit", "X-Ratelimit-Limit"}
+	rateLimitRemainingHeaders = []string{"X-Li-Ratelimit-Remaining", "X-Ratelimit-Remaining"}
+	rateLimitResetHeaders     = []string{"X-Li-Ratelimit-Reset", "X-Ratelimit-Reset"}
+)
+
+// RateLimitStatus returns the last-seen rate-limit headroom. When no response has carried
+// rate-limit headers yet, it returns the zero value with Known set to false.
+func (c *Client) RateLimitStatus() RateLimitStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rateLimit
+}
+
+// recordRateLimit updates the client's rate-limit status from resp, if it carries any hints.
+func (c *Client) recordRateLimit(resp *http.Response, now time.Time) {
+	status, ok := parseRateLimitHeaders(resp.Header, now)
+	if !ok {
+		return
+	}
+	c.mu.Lock()
+	c.rateLimit = status
+	c.mu.Unlock()
+}
+
+// parseRateLimitHeaders extracts rate-limit hints from headers. It reports false when none are present.
+func parseRateLimitHeaders(header http.Header, now time.Time) (RateLimitStatus, bool) {
+	var status RateLimitStatus
+
+	if v, ok := firstHeaderInt(header, rateLimitLimitHeaders); ok {
+		status.Limit = v
+		status.Known = true
+	}
+	if v, ok := firstHeaderInt(header, rateLimitRemainingHeaders); ok {
+		status.Remaining = v
+		status.Known = true
+	}
+	if v, ok := firstHeaderInt(header, rateLimitResetHeaders); ok {
+		// Large values are absolute unix timestamps, small ones are seconds from now.
+		if v > 1_000_000_000 {
+			status.Reset = time.Unix(int64(v), 0)
+		} else {
+			status.Reset = now.Add(time.Duration(v) * time.Second)
+		}
+		status.Known = true
+	}
+	if retryAfter := strings.TrimSpace(header.Get("Retry-After")); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			status.RetryAfter = time.Duration(seconds) * time.Second
+			status.Known = true
+		} else if at, err := http.ParseTime(retryAfter); err == nil {
+			status.RetryAfter = at.Sub(now)
+			status.Known = true
+		}
+		if status.RetryAfter > 0 && status.Reset.IsZero() {
+			status.Reset = now.Add(status.RetryAfter)
+		}
+	}
+
+	return status, status.Known
+}
+
+// firstHeaderInt returns the first of names present in header that parses as an integer.
+func firstHeaderInt(header http.Header, names []string) (int, bool) {
+	for _, name := range names {
+		if v := strings.TrimSpace(header.Get(name)); v != "" {
+			if n, err := strconv.Atoi(v); err == nil {
+				return n, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,155 @@
+package linkedinscraper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// SalesNavSearchArgs represents the arguments for a Sales Navigator lead search.
+// Facet values are Sales Navigator's IDs as they appear in the web app's search URLs.
+type SalesNavSearchArgs struct {
+	Keywords          string
+	SeniorityLevels   []string // SENIORITY_LEVEL facet, e.g. "220" (Director), "300" (Vice President)
+	Functions         []string // FUNCTION facet, e.g. "8" (Engineering), "25" (Sales)
+	CompanyHeadcounts []string // COMPANY_HEADCOUNT facet, e.g. "C" (11-50), "D" (51-200)
+	Start             int
+	Count             int
+}
+
+// SalesNavSearchResponse is the top-level Sales Navigator lead search response.
+type SalesNavSearchResponse struct {
+	Elements []SalesNavLead `json:"elements"`
+	Paging   APIPagingInfo  `json:"paging"`
+}
+
+// SalesNavLead is a single lead in a Sales Navigator search response.
+type SalesNavLead struct {
+	EntityURN        string             `json:"entityUrn"` // e.g. "urn:li:fs_salesProfile:(ACwAAA...,NAME_SEARCH,abcd)"
+	ObjectURN        string             `json:"objectUrn"` // e.g. "urn:li:member:123456"
+	FirstName        string             `json:"firstName"`
+	LastName         string             `json:"lastName"`
+	FullName         string             `json:"fullName"`
+	GeoRegion        string             `json:"geoRegion"`
+	Summary          string             `json:"summary"`
+	CurrentPositions []SalesNavPosition `json:"currentPositions"`
+}
+
+// SalesNavPosition is a current position listed on a Sales Navigator lead.
+type SalesNavPosition struct {
+	Title       string `json:"title"`
+	CompanyName string `json:"companyName"`
+	CompanyURN  string `json:"companyUrn"`
+}
+
+// SearchSalesNavigator searches leads through Sales Navigator, which offers facets the
+// people search lacks. The authenticated account needs a Sales Navigator seat; without
+// one LinkedIn answers 403 (ErrUnauthorized).
+func (c *Client) SearchSalesNavigator(ctx context.Context, args SalesNavSearchArgs) ([]LinkedInProfile, error) {
+	ctx = withOperation(ctx, "SearchSalesNavigator")
+
+	// Input Validation
+	if c.config.Auth.LiAtCookie == "" || c.config.Auth.CSRFToken == "" {
+		return nil, ErrAuthMissing
+	}
+	if args.Keywords == "" && len(args.SeniorityLevels) == 0 && len(args.Functions) == 0 && len(args.CompanyHeadcounts) == 0 {
+		return nil, ErrKeywordsMissing
+	}
+
+	requestURL := buildSalesNavSearchURL(args)
+
+	customHeaders := http.Header{}
+	customHeaders.Set("Accept", "application/json")
+	customHeaders.Set("Referer", "https://www.linkedin.com/sales/search/people")
+
+	resp, respBodyBytes, err := c.makeRequest(ctx, http.MethodGet, requestURL, customHeaders, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRequestFailed, err)
+	}
+	if err := statusError(resp, respBodyBytes); err != nil {
+		return nil, err
+	}
+
+	var apiResponse SalesNavSearchResponse
+	if err := c.decodeResponse(respBodyBytes, &apiResponse); err != nil {
+		return nil, fmt.Errorf("%w: %v. Raw response: %s", ErrResponseParseFailed, err, string(respBodyBytes))
+	}
+
+	return parseSalesNavLeads(&apiResponse, c.parseOptions()), nil
+}
+
+// buildSalesNavSearchURL assembles the lead search URL. Like the GraphQL variables, the
+// Rest.li query must keep its parentheses and commas literal, so it is appended by hand.
+func buildSalesNavSearchURL(args SalesNavSearchArgs) string {
+	var queryParts []string
+	if args.Keywords != "" {
+		queryParts = append(queryParts, "keywords:"+escapeRestliString(args.Keywords))
+	}
+
+	var filters []string
+	for _, facet := range []struct {
+		filterType string
+		ids        []string
+	}{
+		{"SENIORITY_LEVEL", args.SeniorityLevels},
+		{"FUNCTION", args.Functions},
+		{"COMPANY_HEADCOUNT", args.CompanyHeadcounts},
+	} {
+		if len(facet.ids) == 0 {
+			continue
+		}
+		values := make([]string, len(facet.ids))
+		for i, id := range facet.ids {
+			values[i] = fmt.Sprintf("(id:%s,selectionType:INCLUDED)", escapeRestliString(id))
+		}
+		filters = append(filters, fmt.Sprintf("(type:%s,values:List(%s))", facet.filterType, strings.Join(values, ",")))
+	}
+	if len(filters) > 0 {
+		queryParts = append(queryParts, "filters:List("+strings.Join(filters, ",")+")")
+	}
+
+	count := args.Count
+	if count <= 0 {
+		count = 25
+	}
+	return fmt.Sprintf("%s?q=searchQuery&query=(%s)&start=%d&count=%d&decorationId=%s",
+		SalesNavLeadSearchURL, strings.Join(queryParts, ","), args.Start, count, DefaultSalesNavDecorationID)
+}
+
+// parseSalesNavLeads maps Sales Navigator leads onto LinkedInProfile. Leads carry no public
+// identifier; the headline is derived from the first current position.
+func parseSalesNavLeads(apiResponse *SalesNavSearchResponse, opts parseOptions) []LinkedInProfile {
+	profiles := make([]LinkedInProfile, 0, len(apiResponse.Elements))
+	for _, lead := range apiResponse.Elements {
+		profile := LinkedInProfile{
+			URN:       lead.EntityURN,
+			FirstName: lead.FirstName,
+			LastName:  lead.LastName,
+			FullName:  lead.FullName,
+			Location:  lead.GeoRegion,
+			Summary:   lead.Summary,
+			FetchedAt: opts.fetchedAtPtr(),
+		}
+		if profile.FullName == "" {
+			profile.FullName = assembleFullName(lead.FirstName, lead.LastName, resolveNameFormat(opts.nameFormat, nil))
+		}
+		for _, position := range lead.CurrentPositions {
+			profile.Experience = append(profile.Experience, Experience{
+				CompanyName: position.CompanyName,
+				CompanyURN:  position.CompanyURN,
+				Title:       position.Title,
+			})
+		}
+		if len(lead.CurrentPositions) > 0 {
+			current := lead.CurrentPositions[0]
+			if current.Title != "" && current.CompanyName != "" {
+				profile.Headline = current.Title + " at " + current.CompanyName
+			} else {
+				profile.Headline = current.Title + current.CompanyName
+			}
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles
+}
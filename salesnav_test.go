@@ -0,0 +1,64 @@
+package linkedinscraper_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("SearchSalesNavigator", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("parses leads from the fixture", func() {
+		client := newTestClient(newFakeTransport(http.StatusOK, loadFixture("salesnav_search.json")))
+
+		profiles, err := client.SearchSalesNavigator(ctx, linkedinscraper.SalesNavSearchArgs{Keywords: "investor"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profiles).To(HaveLen(2))
+		Expect(profiles[0].URN).To(Equal("urn:li:fs_salesProfile:(ACwAAAJaneDoe,NAME_SEARCH,aBcD)"))
+		Expect(profiles[0].FullName).To(Equal("Jane Doe"))
+		Expect(profiles[0].Headline).To(Equal("Partner at Acme Capital"))
+		Expect(profiles[0].Location).To(Equal("Paris, Île-de-France, France"))
+		Expect(profiles[0].Experience).To(HaveLen(2))
+		Expect(profiles[0].Experience[0].CompanyURN).To(Equal("urn:li:fs_salesCompany:1001"))
+
+		Expect(profiles[1].FullName).To(Equal("John Roe"))
+		Expect(profiles[1].Headline).To(BeEmpty())
+	})
+
+	It("emits the facets in the lead search query", func() {
+		transport := newFakeTransport(http.StatusOK, loadFixture("salesnav_search.json"))
+		_, err := newTestClient(transport).SearchSalesNavigator(ctx, linkedinscraper.SalesNavSearchArgs{
+			Keywords:          "cto",
+			SeniorityLevels:   []string{"220", "300"},
+			Functions:         []string{"8"},
+			CompanyHeadcounts: []string{"C"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		requestURL := transport.Requests()[0].URL
+		Expect(requestURL.Path).To(Equal("/sales-api/salesApiLeadSearch"))
+		Expect(requestURL.RawQuery).To(ContainSubstring("q=searchQuery"))
+		Expect(requestURL.RawQuery).To(ContainSubstring("query=(keywords:cto,filters:List(" +
+			"(type:SENIORITY_LEVEL,values:List((id:220,selectionType:INCLUDED),(id:300,selectionType:INCLUDED)))," +
+			"(type:FUNCTION,values:List((id:8,selectionType:INCLUDED)))," +
+			"(type:COMPANY_HEADCOUNT,values:List((id:C,selectionType:INCLUDED)))))"))
+		Expect(requestURL.RawQuery).To(ContainSubstring("decorationId=" + linkedinscraper.DefaultSalesNavDecorationID))
+	})
+
+	It("requires keywords or a facet", func() {
+		transport := newFakeTransport(http.StatusOK, loadFixture("salesnav_search.json"))
+		_, err := newTestClient(transport).SearchSalesNavigator(ctx, linkedinscraper.SalesNavSearchArgs{})
+		Expect(err).To(MatchError(linkedinscraper.ErrKeywordsMissing))
+		Expect(transport.Requests()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,103 @@
+package linkedinscraper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// scrapeResultSchemaVersion is written to every marshalled ScrapeResult so stored
+// artifacts can be migrated if the envelope changes.
+const scrapeResultSchemaVersion = 1
+
+// ScrapeResult bundles a search, its results and when it ran into a single envelope
+// for storing combined datasets. Its JSON form is stable across library versions.
+type ScrapeResult struct {
+	Args      ProfileSearchArgs
+	Profiles  []LinkedInProfile
+	Metadata  SearchMetadata
+	ScrapedAt time.Time
+}
+
+// scrapeResultJSON is the wire form of ScrapeResult.
+type scrapeResultJSON struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	SearchArgs    searchArgsJSON    `json:"searchArgs"`
+	Profiles      []LinkedInProfile `json:"profiles"`
+	Metadata      SearchMetadata    `json:"metadata"`
+	ScrapedAt     time.Time         `json:"scrapedAt"`
+}
+
+// searchArgsJSON is the wire form of ProfileSearchArgs, which carries no JSON tags itself.
+type searchArgsJSON struct {
+	Keywords              string          `json:"keywords"`
+	NetworkFilters        []NetworkFilter `json:"networkFilters,omitempty"`
+	Start                 int             `json:"start"`
+	Count                 int             `json:"count"`
+	GeoURNs               []string        `json:"geoUrns,omitempty"`
+	GeoRadius             string          `json:"geoRadius,omitempty"`
+	SearchIntent          string          `json:"searchIntent,omitempty"`
+	SortBy                string          `json:"sortBy,omitempty"`
+	IncludePartialResults bool            `json:"includePartialResults,omitempty"`
+	XLiPageInstance       string          `json:"xLiPageInstance,omitempty"`
+	XLiTrack              string          `json:"xLiTrack,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler. ScrapedAt is written in UTC.
+func (r ScrapeResult) MarshalJSON() ([]byte, error) {
+	profiles := r.Profiles
+	if profiles == nil {
+		profiles = []LinkedInProfile{}
+	}
+	return json.Marshal(scrapeResultJSON{
+		SchemaVersion: scrapeResultSchemaVersion,
+		SearchArgs: searchArgsJSON{
+			Keywords:              r.Args.Keywords,
+			NetworkFilters:        r.Args.NetworkFilters,
+			Start:                 r.Args.Start,
+			Count:                 r.Args.Count,
+			GeoURNs:               r.Args.GeoURNs,
+			GeoRadius:             r.Args.GeoRadius,
+			SearchIntent:          r.Args.SearchIntent,
+			SortBy:                r.Args.SortBy,
+			IncludePartialResults: r.Args.IncludePartialResults,
+			XLiPageInstance:       r.Args.XLiPageInstance,
+			XLiTrack:              r.Args.XLiTrack,
+		},
+		Profiles:  profiles,
+		Metadata:  r.Metadata,
+		ScrapedAt: r.ScrapedAt.UTC(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It rejects envelopes written by a newer
+// schema version than this library understands.
+func (r *ScrapeResult) UnmarshalJSON(data []byte) error {
+	var wire scrapeResultJSON
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	if wire.SchemaVersion > scrapeResultSchemaVersion {
+		return fmt.Errorf("linkedinscraper: unsupported ScrapeResult schema version %d", wire.SchemaVersion)
+	}
+
+	*r = ScrapeResult{
+		Args: ProfileSearchArgs{
+			Keywords:              wire.SearchArgs.Keywords,
+			NetworkFilters:        wire.SearchArgs.NetworkFilters,
+			Start:                 wire.SearchArgs.Start,
+			Count:                 wire.SearchArgs.Count,
+			GeoURNs:               wire.SearchArgs.GeoURNs,
+			GeoRadius:             wire.SearchArgs.GeoRadius,
+			SearchIntent:          wire.SearchArgs.SearchIntent,
+			SortBy:                wire.SearchArgs.SortBy,
+			IncludePartialResults: wire.SearchArgs.IncludePartialResults,
+			XLiPageInstance:       wire.SearchArgs.XLiPageInstance,
+			XLiTrack:              wire.SearchArgs.XLiTrack,
+		},
+		Profiles:  wire.Profiles,
+		Metadata:  wire.Metadata,
+		ScrapedAt: wire.ScrapedAt,
+	}
+	return nil
+}
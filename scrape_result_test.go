@@ -0,0 +1,72 @@
+package linkedinscraper_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("ScrapeResult", func() {
+	It("round-trips through JSON", func() {
+		original := linkedinscraper.ScrapeResult{
+			Args: linkedinscraper.ProfileSearchArgs{
+				Keywords:       "investor",
+				NetworkFilters: []linkedinscraper.NetworkFilter{linkedinscraper.NetworkFirstDegree},
+				Start:          10,
+				Count:          10,
+				GeoURNs:        []string{"103644278"},
+				GeoRadius:      "50",
+				SortBy:         linkedinscraper.SortByRecentlyJoined,
+			},
+			Profiles: []linkedinscraper.LinkedInProfile{
+				{URN: "urn:li:member:1", FullName: "Jane Doe", Headline: "Investor", PublicIdentifier: "jane-doe"},
+			},
+			Metadata:  linkedinscraper.SearchMetadata{TotalResults: 42, Start: 10, Count: 10},
+			ScrapedAt: time.Date(2025, time.March, 14, 9, 26, 53, 589_000_000, time.UTC),
+		}
+
+		data, err := json.Marshal(original)
+		Expect(err).NotTo(HaveOccurred())
+
+		var decoded linkedinscraper.ScrapeResult
+		Expect(json.Unmarshal(data, &decoded)).To(Succeed())
+		Expect(decoded).To(Equal(original))
+	})
+
+	It("writes a versioned envelope with camelCase search args", func() {
+		data, err := json.Marshal(linkedinscraper.ScrapeResult{
+			Args:      linkedinscraper.ProfileSearchArgs{Keywords: "investor"},
+			ScrapedAt: time.Date(2025, time.March, 14, 10, 0, 0, 0, time.FixedZone("CET", 3600)),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(data).To(MatchJSON(`{
+			"schemaVersion": 1,
+			"searchArgs": {"keywords": "investor", "start": 0, "count": 0},
+			"profiles": [],
+			"metadata": {"totalResults": 0, "start": 0, "count": 0},
+			"scrapedAt": "2025-03-14T09:00:00Z"
+		}`))
+	})
+
+	It("rejects envelopes from a newer schema version", func() {
+		var decoded linkedinscraper.ScrapeResult
+		Expect(json.Unmarshal([]byte(`{"schemaVersion": 2}`), &decoded)).NotTo(Succeed())
+	})
+
+	It("is filled from SearchProfilesWithMetadata", func() {
+		body := searchResponseJSON(entityResult("urn:li:member:1", "Jane Doe", "Investor", "Paris", "https://www.linkedin.com/in/jane-doe"))
+		profiles, metadata, err := newTestClient(newFakeTransport(http.StatusOK, body)).
+			SearchProfilesWithMetadata(context.Background(), linkedinscraper.ProfileSearchArgs{Keywords: "investor"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(profiles).To(HaveLen(1))
+		Expect(*metadata).To(Equal(linkedinscraper.SearchMetadata{TotalResults: 1, Start: 0, Count: 10}))
+	})
+})
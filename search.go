@@ -6,11 +6,178 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 )
 
 // SearchProfiles searches for LinkedIn profiles based on the provided arguments.
+// A search filtered by geo URNs whose first page comes back without any results fails
+// with ErrInvalidSearchFacet, since that is how LinkedIn answers a mistyped geo URN;
+// callers expecting legitimately empty geo searches can treat it as no results.
 func (c *Client) SearchProfiles(ctx context.Context, args ProfileSearchArgs) ([]LinkedInProfile, error) {
+	profiles, _, err := c.SearchProfilesWithMetadata(ctx, args)
+	return profiles, err
+}
+
+// SearchProfilesWithMetadata is like SearchProfiles but also returns the result paging
+// metadata, e.g. for storing alongside the profiles in a ScrapeResult.
+func (c *Client) SearchProfilesWithMetadata(ctx context.Context, args ProfileSearchArgs) ([]LinkedInProfile, *SearchMetadata, error) {
+	apiResponse, err := c.fetchSearchResults(ctx, args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Depending on requirements, an empty result could return ErrNoProfilesFound.
+	// The current error definition notes "Or handle this by returning empty slice";
+	// the API call itself may have succeeded but yielded no relevant entities.
+	profiles := []LinkedInProfile{}
+	c.extractSearchProfiles(apiResponse, args.IncludePartialResults, func(profile LinkedInProfile) bool {
+		profiles = append(profiles, profile)
+		return true
+	})
+
+	clusters := apiResponse.RootData.InnerData.SearchDashClustersByAll
+	metadata := &SearchMetadata{
+		TotalResults:      clusters.Metadata.TotalResultCount,
+		Start:             clusters.Paging.Start,
+		Count:             clusters.Paging.Count,
+		ContinuationToken: continuationToken(clusters),
+	}
+
+	return profiles, metadata, nil
+}
+
+// SearchProfilesStream is like SearchProfiles but emits each profile on the returned
+// channel as soon as it is extracted, for UIs that render incrementally. Both channels
+// are closed when the search completes; at most one error is sent, after which no more
+// profiles follow. Cancelling ctx stops the stream.
+func (c *Client) SearchProfilesStream(ctx context.Context, args ProfileSearchArgs) (<-chan LinkedInProfile, <-chan error) {
+	profilesCh := make(chan LinkedInProfile)
+	errCh := make(chan error, 1)
+
+	go func() {
+		defer close(profilesCh)
+		defer close(errCh)
+
+		apiResponse, err := c.fetchSearchResults(ctx, args)
+		if err != nil {
+			errCh <- err
+			return
+		}
+
+		c.extractSearchProfiles(apiResponse, args.IncludePartialResults, func(profile LinkedInProfile) bool {
+			select {
+			case profilesCh <- profile:
+				return true
+			case <-ctx.Done():
+				errCh <- ctx.Err()
+				return false
+			}
+		})
+	}()
+
+	return profilesCh, errCh
+}
+
+// SearchAllProfiles pages through a search from args.Start and returns up to maxResults
+// profiles (zero means no limit), stopping early when the results are exhausted. At most
+// Config.MaxSearchPages pages are requested; when that cap is hit the profiles gathered so
+// far are returned and a warning is logged. On error, the profiles gathered so far are
+// returned with it.
+func (c *Client) SearchAllProfiles(ctx context.Context, args ProfileSearchArgs, maxResults int) ([]LinkedInProfile, error) {
+	profiles := []LinkedInProfile{}
+	err := c.paginateSearch(ctx, args, func(page []LinkedInProfile) bool {
+		for _, profile := range page {
+			profiles = append(profiles, profile)
+			if maxResults > 0 && len(profiles) >= maxResults {
+				return false
+			}
+		}
+		return true
+	})
+	return profiles, err
+}
+
+// SearchNewProfiles pages through a search from args.Start and returns only profiles whose
+// URN is not in seen, adding each returned URN to seen so long-running collectors can skip
+// what they already scraped. It stops once maxResults new profiles are found (zero means no
+// limit), the results are exhausted or Config.MaxSearchPages pages were requested. seen must
+// be non-nil for the updates to be visible to the caller. On error, the new profiles
+// collected so far are returned with it.
+func (c *Client) SearchNewProfiles(ctx context.Context, args ProfileSearchArgs, seen map[string]bool, maxResults int) ([]LinkedInProfile, error) {
+	if seen == nil {
+		seen = make(map[string]bool)
+	}
+
+	newProfiles := []LinkedInProfile{}
+	err := c.paginateSearch(ctx, args, func(page []LinkedInProfile) bool {
+		for _, profile := range page {
+			if profile.URN == "" || seen[profile.URN] {
+				continue
+			}
+			seen[profile.URN] = true
+			newProfiles = append(newProfiles, profile)
+			if maxResults > 0 && len(newProfiles) >= maxResults {
+				return false
+			}
+		}
+		return true
+	})
+	return newProfiles, err
+}
+
+// paginateSearch requests consecutive pages of a search from args.Start and passes each
+// page's profiles to visit until visit returns false, the results are exhausted or the
+// page cap is reached. When a page carries a "see all results" continuation token, the
+// next page is requested with it, since the cluster takes the place of results and Start
+// alone would skip or repeat some. Hitting the cap is logged rather than reported as an
+// error, since it usually means LinkedIn reported an inflated total.
+func (c *Client) paginateSearch(ctx context.Context, args ProfileSearchArgs, visit func([]LinkedInProfile) bool) error {
+	maxPages := c.config.MaxSearchPages
+	if maxPages <= 0 {
+		maxPages = DefaultMaxSearchPages
+	}
+
+	for page := 0; ; page++ {
+		if page == maxPages {
+			c.logWarn("search page limit reached, returning partial results",
+				"keywords", args.Keywords, "maxPages", maxPages, "nextStart", args.Start)
+			return nil
+		}
+
+		profiles, metadata, err := c.SearchProfilesWithMetadata(ctx, args)
+		if err != nil {
+			return err
+		}
+		if !visit(profiles) {
+			return nil
+		}
+
+		pageSize := metadata.Count
+		if pageSize <= 0 {
+			pageSize = len(profiles)
+		}
+		args.Start += pageSize
+		token := metadata.ContinuationToken
+		if token == args.ContinuationToken {
+			token = "" // A repeated token makes no progress
+		}
+		if len(profiles) == 0 && token == "" {
+			return nil
+		}
+		// LinkedIn sometimes omits the total or reports 0; then only an empty page ends paging
+		if metadata.TotalResults > 0 && args.Start >= metadata.TotalResults {
+			return nil
+		}
+		args.ContinuationToken = token
+	}
+}
+
+// fetchSearchResults validates args, performs the search request and decodes the response.
+func (c *Client) fetchSearchResults(ctx context.Context, args ProfileSearchArgs) (*SearchAPIResponse, error) {
+	ctx = withOperation(ctx, "SearchProfiles")
+
 	// Input Validation
 	if c.config.Auth.LiAtCookie == "" || c.config.Auth.CSRFToken == "" {
 		return nil, ErrAuthMissing
@@ -18,21 +185,51 @@ func (c *Client) SearchProfiles(ctx context.Context, args ProfileSearchArgs) ([]
 	if args.Keywords == "" {
 		return nil, ErrKeywordsMissing
 	}
+	if !validSortBy(args.SortBy) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidSortBy, args.SortBy)
+	}
 
 	// Construct SearchVariables
+	searchIntent := DefaultSearchIntent // from cURL
+	if args.SearchIntent != "" {
+		searchIntent = args.SearchIntent
+	}
 	querySubQuery := SearchQuerySubQuery{
 		Keywords:                 args.Keywords,
-		FlagshipSearchIntent:     "SEARCH_SRP", // from cURL
+		FlagshipSearchIntent:     searchIntent,
 		QueryParameters:          []SearchQueryParameters{},
 		IncludeFiltersInResponse: false,
 	}
 
+	origin := DefaultSearchOrigin
+	if args.Origin != "" {
+		origin = args.Origin
+	}
+
 	if len(args.NetworkFilters) > 0 {
 		querySubQuery.QueryParameters = append(querySubQuery.QueryParameters, SearchQueryParameters{
 			Key:   "network",
 			Value: args.NetworkFilters, // e.g. List(F,O)
 		})
 	}
+	if len(args.GeoURNs) > 0 {
+		querySubQuery.QueryParameters = append(querySubQuery.QueryParameters, SearchQueryParameters{
+			Key:   "geoUrn",
+			Value: args.GeoURNs,
+		})
+		if args.GeoRadius != "" {
+			querySubQuery.QueryParameters = append(querySubQuery.QueryParameters, SearchQueryParameters{
+				Key:   "distance",
+				Value: []string{args.GeoRadius},
+			})
+		}
+	}
+	if args.SortBy != "" {
+		querySubQuery.QueryParameters = append(querySubQuery.QueryParameters, SearchQueryParameters{
+			Key:   "sortBy",
+			Value: []string{args.SortBy},
+		})
+	}
 	// Add other fixed queryParameters from cURL like (key:resultType,value:List(PEOPLE))
 	querySubQuery.QueryParameters = append(querySubQuery.QueryParameters, SearchQueryParameters{
 		Key:   "resultType",
@@ -40,16 +237,11 @@ func (c *Client) SearchProfiles(ctx context.Context, args ProfileSearchArgs) ([]
 	})
 
 	variables := SearchVariables{
-		Start:  args.Start,
-		Count:  args.Count,       // Populate Count from args
-		Origin: "FACETED_SEARCH", // from cURL
-		Query:  querySubQuery,
-	}
-
-	// Build URL
-	requestURL, err := buildGraphQLURL(VoyagerBaseURL, DefaultSearchQueryID, variables)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrRequestBuildFailed, err) // Wrap ErrRequestBuildFailed
+		Start:           args.Start,
+		Count:           args.Count, // Populate Count from args
+		Origin:          origin,
+		Query:           querySubQuery,
+		PaginationToken: args.ContinuationToken,
 	}
 
 	// Prepare Headers
@@ -67,7 +259,14 @@ func (c *Client) SearchProfiles(ctx context.Context, args ProfileSearchArgs) ([]
 		networkFilterString := "[\"" + strings.Join(args.NetworkFilters, "\",\"") + "\"]"
 		refererQueryParts = append(refererQueryParts, "network="+networkFilterString) // Do not QueryEscape the already formatted JSON string
 	}
-	refererQueryParts = append(refererQueryParts, "origin=FACETED_SEARCH")
+	if len(args.GeoURNs) > 0 {
+		geoFilterString := "[\"" + strings.Join(args.GeoURNs, "\",\"") + "\"]"
+		refererQueryParts = append(refererQueryParts, "geoUrn="+geoFilterString)
+	}
+	if args.SortBy != "" {
+		refererQueryParts = append(refererQueryParts, "sortBy=[\""+args.SortBy+"\"]")
+	}
+	refererQueryParts = append(refererQueryParts, "origin="+url.QueryEscape(origin))
 
 	baseURLForReferer := "https://www.linkedin.com/search/results/people/"
 	fullRefererURL := baseURLForReferer + "?" + strings.Join(refererQueryParts, "&")
@@ -82,78 +281,245 @@ func (c *Client) SearchProfiles(ctx context.Context, args ProfileSearchArgs) ([]
 
 	customHeaders.Set("X-Li-Pem-Metadata", "Voyager - People SRP=search-results")
 
-	// Use XLiTrack from args if provided, otherwise use placeholder matching cURL structure
-	// cURL: {"clientVersion":"1.13.35368","mpVersion":"1.13.35368","osName":"web","timezoneOffset":-7,"timezone":"America/Los_Angeles","deviceFormFactor":"DESKTOP","mpName":"voyager-web","displayDensity":2,"displayWidth":5120,"displayHeight":2880}
-	xLiTrack := `{"clientVersion":"1.13.35368","mpVersion":"1.13.35368","osName":"web","timezoneOffset":-7,"timezone":"America/Los_Angeles","deviceFormFactor":"DESKTOP","mpName":"voyager-web","displayDensity":2,"displayWidth":1920,"displayHeight":1080}` // Default placeholder, using common display W/H
+	// Use XLiTrack from args if provided, otherwise the config's, in the cURL's structure:
+	// {"clientVersion":"1.13.35368","mpVersion":"1.13.35368","osName":"web","timezoneOffset":-7,"timezone":"America/Los_Angeles","deviceFormFactor":"DESKTOP","mpName":"voyager-web","displayDensity":2,"displayWidth":5120,"displayHeight":2880}
+	xLiTrack := c.config.xLiTrack(c.now())
 	if args.XLiTrack != "" {
 		xLiTrack = args.XLiTrack
 	}
 	customHeaders.Set("X-Li-Track", xLiTrack)
 
-	// Make API Call
-	resp, respBodyBytes, err := c.makeRequest(ctx, http.MethodGet, requestURL, customHeaders, nil)
+	// Try each configured query ID in order, falling back when LinkedIn reports one as deprecated
+	apiResponse, err := withQueryIDFallback(c.config.searchQueryIDs(), func(queryID string) (*SearchAPIResponse, error) {
+		// Build URL
+		requestURL, err := buildGraphQLURL(VoyagerBaseURL, queryID, variables)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrRequestBuildFailed, err) // Wrap ErrRequestBuildFailed
+		}
+
+		// Make API Call
+		resp, respBodyBytes, err := c.makeRequest(ctx, http.MethodGet, requestURL, customHeaders, nil)
+		if err != nil {
+			// It might be beneficial to inspect the error type if makeRequest returns a wrapped error
+			// that could indicate a more specific issue (e.g., context canceled, network error before HTTP execution)
+			return nil, fmt.Errorf("%w: %w", ErrRequestFailed, err) // Wrap ErrRequestFailed
+		}
+
+		// Error Handling (HTTP Status)
+		if err := statusError(resp, respBodyBytes); err != nil {
+			return nil, err
+		}
+
+		// Parse JSON Response
+		var apiResponse SearchAPIResponse
+		err = c.decodeResponse(respBodyBytes, &apiResponse)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v. Raw response: %s", ErrResponseParseFailed, sanitizeURL(requestURL), err, string(respBodyBytes))
+		}
+		if c.provenance {
+			apiResponse.provenance = &Provenance{
+				Operation: "SearchProfiles",
+				QueryID:   queryID,
+				Variables: BuildSearchVariablesString(variables),
+			}
+		}
+
+		return &apiResponse, nil
+	})
 	if err != nil {
-		// It might be beneficial to inspect the error type if makeRequest returns a wrapped error
-		// that could indicate a more specific issue (e.g., context canceled, network error before HTTP execution)
-		return nil, fmt.Errorf("%w: %v", ErrRequestFailed, err) // Wrap ErrRequestFailed
+		return nil, err
+	}
+
+	// LinkedIn answers an unknown geo URN with an empty 200 rather than an error. Network
+	// codes cannot be mistyped that way, so a network-only search may legitimately be
+	// empty, and past the first page an empty response just means the results ran out.
+	firstPage := args.Start == 0 && args.ContinuationToken == ""
+	if len(args.GeoURNs) > 0 && firstPage && isEmptySearchResponse(apiResponse) {
+		return nil, fmt.Errorf("%w: geoUrn %v", ErrInvalidSearchFacet, args.GeoURNs)
 	}
+	return apiResponse, nil
+}
 
-	// Error Handling (HTTP Status)
-	if resp.StatusCode != http.StatusOK {
-		switch resp.StatusCode {
-		case http.StatusUnauthorized, http.StatusForbidden:
-			return nil, fmt.Errorf("%w: status %d, body: %s", ErrUnauthorized, resp.StatusCode, string(respBodyBytes))
-		case http.StatusTooManyRequests:
-			return nil, fmt.Errorf("%w: status %d, body: %s", ErrRateLimited, resp.StatusCode, string(respBodyBytes))
-		default:
-			return nil, fmt.Errorf("%w: received status code %d, body: %s", ErrRequestFailed, resp.StatusCode, string(respBodyBytes))
+// continuationToken returns the pagination token of the first "see all results" cluster
+// in a search response, or "" when there is none.
+func continuationToken(clusters SearchDashClusters) string {
+	for _, element := range clusters.Elements {
+		for _, item := range element.Items {
+			if item.SeeAllResults != nil && item.SeeAllResults.PaginationToken != "" {
+				return item.SeeAllResults.PaginationToken
+			}
 		}
 	}
+	return ""
+}
 
-	// Parse JSON Response
-	var apiResponse SearchAPIResponse
-	err = json.Unmarshal(respBodyBytes, &apiResponse)
+// isEmptySearchResponse reports whether a search response has neither result clusters
+// nor a result total.
+func isEmptySearchResponse(apiResponse *SearchAPIResponse) bool {
+	clusters := apiResponse.RootData.InnerData.SearchDashClustersByAll
+	return len(clusters.Elements) == 0 && clusters.Metadata.TotalResultCount == 0 && clusters.Paging.Total == 0
+}
+
+// searchResultsPageSize is the number of results the web client shows per search page.
+const searchResultsPageSize = 10
+
+// ProfileSearchArgsFromURL builds ProfileSearchArgs from a people search URL copied from the
+// browser, e.g. https://www.linkedin.com/search/results/people/?keywords=investor&network=%5B%22F%22%5D&origin=FACETED_SEARCH,
+// reversing how the client builds its Referer. It reads keywords, the origin, and the
+// network, geoUrn and sortBy facets, whose values are JSON arrays, and turns the page
+// parameter into Start with a Count of one web page. Tracking parameters such as sid are
+// ignored. Keywords are required like in SearchProfiles, so a filter-only URL, e.g. one
+// with just a network facet, is rejected with ErrKeywordsMissing.
+func ProfileSearchArgsFromURL(searchURL string) (ProfileSearchArgs, error) {
+	var args ProfileSearchArgs
+	parsed, err := url.Parse(strings.TrimSpace(searchURL))
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v. Raw response: %s", ErrResponseParseFailed, err, string(respBodyBytes))
+		return args, fmt.Errorf("%w: %v", ErrInvalidSearchURL, err)
+	}
+	host := strings.ToLower(parsed.Hostname())
+	if (host != "linkedin.com" && !strings.HasSuffix(host, ".linkedin.com")) ||
+		strings.TrimSuffix(parsed.Path, "/") != "/search/results/people" {
+		return args, fmt.Errorf("%w: %s", ErrInvalidSearchURL, searchURL)
+	}
+
+	query := parsed.Query()
+	args.Keywords = query.Get("keywords")
+	if args.Keywords == "" {
+		return args, ErrKeywordsMissing
+	}
+	args.Origin = query.Get("origin")
+
+	facets := []struct {
+		name   string
+		target *[]string
+	}{
+		{"network", &args.NetworkFilters},
+		{"geoUrn", &args.GeoURNs},
+	}
+	for _, facet := range facets {
+		if value := query.Get(facet.name); value != "" {
+			if err := json.Unmarshal([]byte(value), facet.target); err != nil {
+				return args, fmt.Errorf("%w: %s facet is not a JSON array: %v", ErrInvalidSearchURL, facet.name, err)
+			}
+		}
+	}
+
+	if value := query.Get("sortBy"); value != "" {
+		var sortBy []string
+		if err := json.Unmarshal([]byte(value), &sortBy); err != nil || len(sortBy) != 1 {
+			return args, fmt.Errorf("%w: sortBy facet must hold one value", ErrInvalidSearchURL)
+		}
+		if !validSortBy(sortBy[0]) {
+			return args, fmt.Errorf("%w: %q", ErrInvalidSortBy, sortBy[0])
+		}
+		args.SortBy = sortBy[0]
+	}
+
+	args.Count = searchResultsPageSize
+	if value := query.Get("page"); value != "" {
+		page, err := strconv.Atoi(value)
+		if err != nil || page < 1 {
+			return args, fmt.Errorf("%w: invalid page %q", ErrInvalidSearchURL, value)
+		}
+		args.Start = (page - 1) * searchResultsPageSize
 	}
+	return args, nil
+}
+
+// Clone returns a copy of a that shares no slices with it, so either can be changed
+// without affecting the other.
+func (a ProfileSearchArgs) Clone() ProfileSearchArgs {
+	a.NetworkFilters = slices.Clone(a.NetworkFilters)
+	a.GeoURNs = slices.Clone(a.GeoURNs)
+	return a
+}
+
+// WithStart returns a copy of a starting at result offset start, e.g. for the next page.
+func (a ProfileSearchArgs) WithStart(start int) ProfileSearchArgs {
+	a = a.Clone()
+	a.Start = start
+	return a
+}
+
+// WithCount returns a copy of a requesting count results per page.
+func (a ProfileSearchArgs) WithCount(count int) ProfileSearchArgs {
+	a = a.Clone()
+	a.Count = count
+	return a
+}
 
-	// Extract Profiles
-	var profiles []LinkedInProfile
+// WithNetworkFilters returns a copy of a restricted to the given connection degrees,
+// replacing any filters a already had. No filters searches every degree.
+func (a ProfileSearchArgs) WithNetworkFilters(filters ...NetworkFilter) ProfileSearchArgs {
+	a = a.Clone()
+	a.NetworkFilters = slices.Clone(filters)
+	return a
+}
+
+// extractSearchProfiles builds LinkedInProfiles from a search response, passing each to
+// emit in response order. Extraction stops early when emit returns false. Results missing
+// a title or subtitle are skipped unless includePartial is set, in which case any result
+// with a URN is kept with whatever fields are available.
+func (c *Client) extractSearchProfiles(apiResponse *SearchAPIResponse, includePartial bool, emit func(LinkedInProfile) bool) {
 	profileDataMap := make(map[string]IncludedProfile) // To store IncludedProfile data by URN for enrichment
+	industryNames := make(map[string]string)           // Industry names by industry URN
+	fetchedAt := c.now()
 
 	// First pass: collect all IncludedProfile data
 	for _, item := range apiResponse.Included {
-		if item.Type == "com.linkedin.voyager.dash.identity.profile.Profile" {
+		if item.Type == EntityTypeProfile {
 			// Check for nil pointers before dereferencing, though fields are not pointers in IncludedProfile itself based on current models.go
 			// However, item itself could represent a partially unmarshalled element if not all fields were present.
 			// For simplicity, we'll assume direct field access is safe if Type matches.
-			profileDataMap[item.EntityURN] = IncludedProfile{
+			// Key by both the profile and member URN, since results may track either.
+			profileData := IncludedProfile{
 				EntityURN:        item.EntityURN,
 				PublicIdentifier: item.PublicIdentifier,
 				FirstName:        item.FirstName,
 				LastName:         item.LastName,
 				Headline:         item.Headline,
+				IndustryURN:      item.IndustryURN,
 			}
+			profileDataMap[profileURNKey(item.EntityURN)] = profileData
+			if item.ObjectURN != "" {
+				profileDataMap[profileURNKey(item.ObjectURN)] = profileData
+			}
+		}
+		if item.Type == EntityTypeIndustry && item.Name != "" {
+			industryNames[item.EntityURN] = item.Name
 		}
 	}
 
 	// Second pass: build LinkedInProfile from EntityResultViewModel, enriching with Profile data
 	for _, item := range apiResponse.Included {
-		if item.Type == "com.linkedin.voyager.dash.search.EntityResultViewModel" {
-			if item.Title == nil || item.PrimarySubtitle == nil || item.SecondarySubtitle == nil {
-				// Skip if essential fields are missing to avoid nil pointer dereference
-				// Consider logging this case if robust error handling/reporting is needed
+		if item.Type == EntityTypeEntityResultViewModel {
+			partial := item.Title == "" || item.PrimarySubtitle == "" || item.SecondarySubtitle == ""
+			if partial && (!includePartial || item.TrackingURN == "") {
+				// Skip results missing a name, headline or location, whether null or empty
 				continue
 			}
 
+			// Each profile gets its own copy so changing one result's FetchedAt leaves the others alone
+			profileFetchedAt := fetchedAt
 			profile := LinkedInProfile{
 				URN:        item.TrackingURN, // TrackingURN from EntityResultViewModel is often the profile URN
-				FullName:   string(*item.Title),
-				Headline:   string(*item.PrimarySubtitle),
-				Location:   string(*item.SecondarySubtitle),
 				ProfileURL: item.NavigationURL,
+				TrackingID: item.TrackingId,
+				FetchedAt:  &profileFetchedAt,
 				// PublicIdentifier can come from EntityResultViewModel itself or be enriched
 			}
+			profile.FullName = string(item.Title)
+			profile.Headline = string(item.PrimarySubtitle)
+			if subtitle := string(item.SecondarySubtitle); isLocationSubtitle(subtitle) {
+				profile.Location = subtitle
+			} else {
+				profile.SubtitleInsight = subtitle
+			}
+			profile.NetworkDistance = networkDistanceCode(string(item.BadgeText))
+
+			if !c.config.KeepTrackingParams {
+				profile.ProfileURL = stripTrackingParams(profile.ProfileURL)
+			}
 
 			// Attempt to get PublicIdentifier directly from EntityResultViewModel's own PublicIdentifier field if it exists and is populated
 			if item.PublicIdentifier != "" {
@@ -161,10 +527,12 @@ func (c *Client) SearchProfiles(ctx context.Context, args ProfileSearchArgs) ([]
 			}
 
 			// Enrich with data from IncludedProfile if available, prioritizing already set publicIdentifier
-			if linkedProfileData, ok := profileDataMap[item.TrackingURN]; ok {
+			if linkedProfileData, ok := profileDataMap[profileURNKey(item.TrackingURN)]; ok {
 				if profile.PublicIdentifier == "" && linkedProfileData.PublicIdentifier != "" {
 					profile.PublicIdentifier = linkedProfileData.PublicIdentifier
 				}
+				profile.IndustryURN = linkedProfileData.IndustryURN
+				profile.Industry = industryNames[linkedProfileData.IndustryURN]
 				// Potentially update other fields if EntityResultViewModel's were less complete, e.g. headline
 				// For now, we primarily use EntityResultViewModel and supplement publicId
 			}
@@ -175,18 +543,65 @@ func (c *Client) SearchProfiles(ctx context.Context, args ProfileSearchArgs) ([]
 			// Public ID can sometimes be part of another field or require a separate lookup/parsing strategy if not directly available.
 			// For now, we rely on it being present in either EntityResultViewModel or IncludedProfile.
 
-			profiles = append(profiles, profile)
+			if apiResponse.provenance != nil {
+				provenance := *apiResponse.provenance
+				profile.Provenance = &provenance
+			}
+
+			markPrivateMember(&profile)
+			if !emit(profile) {
+				return
+			}
 		}
 	}
+}
+
+// stripTrackingParams removes the query string and fragment from a profile URL,
+// e.g. "https://www.linkedin.com/in/jane?miniProfileUrn=..." becomes "https://www.linkedin.com/in/jane".
+// URLs that fail to parse are returned unchanged.
+func stripTrackingParams(rawURL string) string {
+	if rawURL == "" {
+		return rawURL
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	parsed.RawQuery = ""
+	parsed.Fragment = ""
+	return parsed.String()
+}
+
+// subtitleInsightPrefixes start secondary subtitles that describe the member rather than
+// their location, as LinkedIn shows them when a search keyword matched a past or current role.
+var subtitleInsightPrefixes = []string{"current:", "past:", "summary:", "skills:", "services:"}
 
-	if len(profiles) == 0 {
-		// Depending on requirements, could return ErrNoProfilesFound or empty slice.
-		// The current error definition notes "Or handle this by returning empty slice"
-		// For now, let's stick to returning an empty slice if no profiles were parsed,
-		// as the API call itself might have been successful but yielded no relevant entities.
-		// If an error like ErrNoProfilesFound is desired, it should be returned here.
-		return []LinkedInProfile{}, nil
+// isLocationSubtitle reports whether a search result's secondary subtitle looks like a
+// location. Insight prefixes and role phrases such as "VP at Acme" are not locations.
+func isLocationSubtitle(subtitle string) bool {
+	lower := strings.ToLower(strings.TrimSpace(subtitle))
+	if lower == "" {
+		return true
+	}
+	for _, prefix := range subtitleInsightPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			return false
+		}
 	}
+	return !strings.Contains(lower, " at ") && !strings.Contains(lower, "connection")
+}
+
+// profileURNNamespaces are the URN namespaces that identify a profile by the same
+// "ACoAA..." ID, e.g. urn:li:fsd_profile:ACoAA... and urn:li:fs_miniProfile:ACoAA....
+var profileURNNamespaces = []string{"urn:li:fsd_profile:", "urn:li:fs_profile:", "urn:li:fs_miniProfile:"}
 
-	return profiles, nil
+// profileURNKey normalizes a profile URN for lookups so the profile namespaces map to the
+// same key. Member URNs and other URNs are returned unchanged.
+func profileURNKey(urn string) string {
+	for _, namespace := range profileURNNamespaces {
+		if id, ok := strings.CutPrefix(urn, namespace); ok {
+			return "profile:" + id
+		}
+	}
+	return urn
 }
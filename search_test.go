@@ -0,0 +1,730 @@
+package linkedinscraper_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("SearchProfiles", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	// requestedStart extracts the start offset from a search request's variables.
+	startPattern := regexp.MustCompile(`\(start:(\d+),`)
+	requestedStart := func(req *http.Request) int {
+		variables, err := url.QueryUnescape(req.URL.RawQuery)
+		Expect(err).NotTo(HaveOccurred())
+		start, err := strconv.Atoi(startPattern.FindStringSubmatch(variables)[1])
+		Expect(err).NotTo(HaveOccurred())
+		return start
+	}
+
+	search := func(client *linkedinscraper.Client) []linkedinscraper.LinkedInProfile {
+		profiles, err := client.SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor", Count: 10})
+		Expect(err).NotTo(HaveOccurred())
+		return profiles
+	}
+
+	Describe("ProfileURL tracking parameters", func() {
+		DescribeTable("strips tracking parameters by default",
+			func(navigationURL, expected string) {
+				body := searchResponseJSON(entityResult("urn:li:member:1", "Jane Doe", "Investor", "Paris", navigationURL))
+				profiles := search(newTestClient(newFakeTransport(http.StatusOK, body)))
+
+				Expect(profiles).To(HaveLen(1))
+				Expect(profiles[0].ProfileURL).To(Equal(expected))
+			},
+			Entry("with tracking params",
+				"https://www.linkedin.com/in/jane-doe?miniProfileUrn=urn%3Ali%3Afs_miniProfile%3AACoAAA&trackingId=abc",
+				"https://www.linkedin.com/in/jane-doe"),
+			Entry("without tracking params",
+				"https://www.linkedin.com/in/jane-doe",
+				"https://www.linkedin.com/in/jane-doe"),
+			Entry("with a fragment",
+				"https://www.linkedin.com/in/jane-doe?trackingId=abc#about",
+				"https://www.linkedin.com/in/jane-doe"),
+			Entry("empty URL", "", ""),
+		)
+
+		It("keeps tracking parameters when KeepTrackingParams is set", func() {
+			navigationURL := "https://www.linkedin.com/in/jane-doe?miniProfileUrn=urn%3Ali%3Afs_miniProfile%3AACoAAA&trackingId=abc"
+			body := searchResponseJSON(entityResult("urn:li:member:1", "Jane Doe", "Investor", "Paris", navigationURL))
+			client := newTestClient(newFakeTransport(http.StatusOK, body), func(cfg *linkedinscraper.Config) {
+				cfg.KeepTrackingParams = true
+			})
+
+			profiles := search(client)
+			Expect(profiles).To(HaveLen(1))
+			Expect(profiles[0].ProfileURL).To(Equal(navigationURL))
+		})
+	})
+
+	Describe("geographic radius", func() {
+		requestQuery := func(args linkedinscraper.ProfileSearchArgs) string {
+			transport := newFakeTransport(http.StatusOK, searchResponseJSON(entityResult("urn:li:member:1", "Jane Doe", "Investor", "Paris", "https://www.linkedin.com/in/jane-doe")))
+			_, err := newTestClient(transport).SearchProfiles(ctx, args)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(transport.Requests()).To(HaveLen(1))
+			return transport.Requests()[0].URL.RawQuery
+		}
+
+		It("emits the distance parameter alongside a geo URN", func() {
+			query := requestQuery(linkedinscraper.ProfileSearchArgs{
+				Keywords:  "investor",
+				GeoURNs:   []string{"103644278"},
+				GeoRadius: "50",
+			})
+			Expect(query).To(ContainSubstring("(key:geoUrn,value:List(103644278))"))
+			Expect(query).To(ContainSubstring("(key:distance,value:List(50))"))
+		})
+
+		It("omits the distance parameter without a geo URN", func() {
+			query := requestQuery(linkedinscraper.ProfileSearchArgs{
+				Keywords:  "investor",
+				GeoRadius: "50",
+			})
+			Expect(query).NotTo(ContainSubstring("geoUrn"))
+			Expect(query).NotTo(ContainSubstring("distance"))
+		})
+	})
+
+	Describe("boolean keyword syntax", func() {
+		It("sends quoted phrases and operators verbatim", func() {
+			keywords := `"software engineer" AND (Go OR Rust) NOT intern`
+			transport := newFakeTransport(http.StatusOK, searchResponseJSON())
+			_, err := newTestClient(transport).SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{Keywords: keywords})
+			Expect(err).NotTo(HaveOccurred())
+
+			rawQuery := transport.Requests()[0].URL.RawQuery
+			variables := rawQuery[strings.Index(rawQuery, "variables=")+len("variables="):]
+			Expect(variables).To(ContainSubstring("keywords:%22software%20engineer%22%20AND%20%28Go%20OR%20Rust%29%20NOT%20intern,"))
+
+			decoded, err := url.PathUnescape(variables)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded).To(ContainSubstring("keywords:" + keywords + ","))
+		})
+	})
+
+	Describe("SearchProfilesStream", func() {
+		It("emits every profile and closes both channels", func() {
+			body := searchResponseJSON(
+				entityResult("urn:li:member:1", "Jane Doe", "Investor", "Paris", "https://www.linkedin.com/in/jane-doe"),
+				entityResult("urn:li:member:2", "John Roe", "Founder", "Berlin", "https://www.linkedin.com/in/john-roe"),
+				entityResult("urn:li:member:3", "Ada Poe", "Engineer", "London", "https://www.linkedin.com/in/ada-poe"),
+			)
+			client := newTestClient(newFakeTransport(http.StatusOK, body))
+
+			profilesCh, errCh := client.SearchProfilesStream(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor"})
+
+			var names []string
+			for profile := range profilesCh {
+				names = append(names, profile.FullName)
+			}
+			Expect(names).To(Equal([]string{"Jane Doe", "John Roe", "Ada Poe"}))
+			Expect(errCh).To(BeClosed())
+		})
+
+		It("reports request errors on the error channel", func() {
+			client := newTestClient(newFakeTransport(http.StatusTooManyRequests, nil))
+
+			profilesCh, errCh := client.SearchProfilesStream(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor"})
+
+			Eventually(profilesCh).Should(BeClosed())
+			var err error
+			Eventually(errCh).Should(Receive(&err))
+			Expect(err).To(MatchError(linkedinscraper.ErrRateLimited))
+		})
+	})
+
+	It("names the request URL when LinkedIn rejects the search", func() {
+		client := newTestClient(newFakeTransport(http.StatusTooManyRequests, nil))
+
+		_, err := client.SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor"})
+		Expect(err).To(MatchError(linkedinscraper.ErrRateLimited))
+		Expect(err.Error()).To(ContainSubstring(linkedinscraper.VoyagerBaseURL))
+	})
+
+	Describe("SearchNewProfiles", func() {
+		// pagedTransport serves six results, two per page, based on the requested start offset.
+		pagedTransport := func() *fakeTransport {
+			var results []map[string]interface{}
+			for i := 1; i <= 6; i++ {
+				id := strconv.Itoa(i)
+				results = append(results, entityResult("urn:li:member:"+id, "Member "+id, "Investor", "Paris", "https://www.linkedin.com/in/member-"+id))
+			}
+			return &fakeTransport{handler: func(req *http.Request) *http.Response {
+				start := requestedStart(req)
+				end := min(start+2, len(results))
+				return newResponse(http.StatusOK, pagedSearchResponseJSON(start, 2, len(results), results[start:end]...))
+			}}
+		}
+
+		It("skips seen profiles across pages and records the new ones", func() {
+			transport := pagedTransport()
+			seen := map[string]bool{"urn:li:member:1": true, "urn:li:member:2": true, "urn:li:member:4": true}
+
+			profiles, err := newTestClient(transport).SearchNewProfiles(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor", Count: 2}, seen, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			var urns []string
+			for _, profile := range profiles {
+				urns = append(urns, profile.URN)
+			}
+			Expect(urns).To(Equal([]string{"urn:li:member:3", "urn:li:member:5", "urn:li:member:6"}))
+			Expect(seen).To(HaveLen(6))
+			Expect(transport.Requests()).To(HaveLen(3))
+		})
+
+		It("stops once maxResults new profiles are found", func() {
+			transport := pagedTransport()
+			seen := map[string]bool{"urn:li:member:1": true}
+
+			profiles, err := newTestClient(transport).SearchNewProfiles(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor", Count: 2}, seen, 2)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(profiles).To(HaveLen(2))
+			Expect(profiles[1].URN).To(Equal("urn:li:member:3"))
+			Expect(seen).To(HaveKey("urn:li:member:3"))
+			Expect(seen).NotTo(HaveKey("urn:li:member:4"))
+			Expect(transport.Requests()).To(HaveLen(2))
+		})
+	})
+
+	Describe("SearchAllProfiles", func() {
+		// endlessTransport always serves a full page and claims many more results remain.
+		endlessTransport := func() *fakeTransport {
+			return &fakeTransport{handler: func(req *http.Request) *http.Response {
+				start := requestedStart(req)
+				first, second := strconv.Itoa(start+1), strconv.Itoa(start+2)
+				return newResponse(http.StatusOK, pagedSearchResponseJSON(start, 2, 1_000_000,
+					entityResult("urn:li:member:"+first, "Member "+first, "Investor", "Paris", "https://www.linkedin.com/in/member-"+first),
+					entityResult("urn:li:member:"+second, "Member "+second, "Investor", "Paris", "https://www.linkedin.com/in/member-"+second),
+				))
+			}}
+		}
+
+		It("collects pages until maxResults", func() {
+			transport := endlessTransport()
+
+			profiles, err := newTestClient(transport).SearchAllProfiles(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor", Count: 2}, 5)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profiles).To(HaveLen(5))
+			Expect(profiles[4].URN).To(Equal("urn:li:member:5"))
+			Expect(transport.Requests()).To(HaveLen(3))
+		})
+
+		It("stops at MaxSearchPages and warns", func() {
+			transport := endlessTransport()
+			var logs bytes.Buffer
+			cfg, err := linkedinscraper.NewConfig(linkedinscraper.AuthCredentials{LiAtCookie: "test-li-at", JSESSIONID: "ajax:test-csrf"})
+			Expect(err).NotTo(HaveOccurred())
+			cfg.MaxSearchPages = 3
+			client, err := linkedinscraper.NewClient(cfg,
+				linkedinscraper.WithHTTPClient(&http.Client{Transport: transport}),
+				linkedinscraper.WithLogger(slog.New(slog.NewTextHandler(&logs, nil))),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			profiles, err := client.SearchAllProfiles(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor", Count: 2}, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profiles).To(HaveLen(6))
+			Expect(transport.Requests()).To(HaveLen(3))
+			Expect(logs.String()).To(ContainSubstring("search page limit reached"))
+		})
+
+		It("keeps paging until an empty page when no total is reported", func() {
+			transport := &fakeTransport{handler: func(req *http.Request) *http.Response {
+				start := requestedStart(req)
+				if start >= 4 {
+					return newResponse(http.StatusOK, pagedSearchResponseJSON(start, 2, 0))
+				}
+				first, second := strconv.Itoa(start+1), strconv.Itoa(start+2)
+				return newResponse(http.StatusOK, pagedSearchResponseJSON(start, 2, 0,
+					entityResult("urn:li:member:"+first, "Member "+first, "Investor", "Paris", "https://www.linkedin.com/in/member-"+first),
+					entityResult("urn:li:member:"+second, "Member "+second, "Investor", "Paris", "https://www.linkedin.com/in/member-"+second),
+				))
+			}}
+
+			profiles, err := newTestClient(transport).SearchAllProfiles(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor", Count: 2}, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profiles).To(HaveLen(4))
+			Expect(transport.Requests()).To(HaveLen(3))
+		})
+	})
+
+	Describe("see all clusters", func() {
+		const token = "dXJuOmxpOnNlYXJjaDpwZW9wbGU6Mg=="
+
+		It("exposes the continuation token in the metadata", func() {
+			transport := newFakeTransport(http.StatusOK, loadFixture("search_see_all.json"))
+			profiles, metadata, err := newTestClient(transport).SearchProfilesWithMetadata(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor", Count: 2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profiles).To(HaveLen(1))
+			Expect(metadata.ContinuationToken).To(Equal(token))
+		})
+
+		It("leaves the token empty without a see all cluster", func() {
+			transport := newFakeTransport(http.StatusOK, searchResponseJSON(entityResult("urn:li:member:1", "Jane Doe", "Investor", "Paris", "")))
+			_, metadata, err := newTestClient(transport).SearchProfilesWithMetadata(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metadata.ContinuationToken).To(BeEmpty())
+		})
+
+		It("continues SearchAllProfiles with the token", func() {
+			transport := &fakeTransport{handler: func(req *http.Request) *http.Response {
+				if strings.Contains(req.URL.RawQuery, "paginationToken:") {
+					return newResponse(http.StatusOK, pagedSearchResponseJSON(2, 2, 4,
+						entityResult("urn:li:member:2", "John Roe", "Engineer", "Berlin", "https://www.linkedin.com/in/john-roe"),
+						entityResult("urn:li:member:3", "Max Poe", "Founder", "Vienna", "https://www.linkedin.com/in/max-poe"),
+					))
+				}
+				return newResponse(http.StatusOK, loadFixture("search_see_all.json"))
+			}}
+
+			profiles, err := newTestClient(transport).SearchAllProfiles(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor", Count: 2}, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profiles).To(HaveLen(3))
+
+			requests := transport.Requests()
+			Expect(requests).To(HaveLen(2))
+			Expect(requests[0].URL.RawQuery).NotTo(ContainSubstring("paginationToken"))
+			Expect(requests[1].URL.RawQuery).To(ContainSubstring("paginationToken:dXJuOmxpOnNlYXJjaDpwZW9wbGU6Mg%3D%3D,"))
+		})
+	})
+
+	Describe("BuildSearchVariablesString", func() {
+		variables := linkedinscraper.SearchVariables{
+			Start:  10,
+			Count:  10,
+			Origin: "FACETED_SEARCH",
+			Query: linkedinscraper.SearchQuerySubQuery{
+				Keywords:             "venture capital",
+				FlagshipSearchIntent: linkedinscraper.DefaultSearchIntent,
+				QueryParameters: []linkedinscraper.SearchQueryParameters{
+					{Key: "network", Value: []string{"F", "S"}},
+					{Key: "geoUrn", Value: []string{"103644278", "101165590"}},
+					{Key: "resultType", Value: []string{"PEOPLE"}},
+				},
+			},
+		}
+
+		It("matches the documented format for a multi-facet search", func() {
+			Expect(linkedinscraper.BuildSearchVariablesString(variables)).To(Equal(
+				"(start:10,count:10,origin:FACETED_SEARCH,query:(keywords:venture%20capital,flagshipSearchIntent:SEARCH_SRP," +
+					"queryParameters:List((key:network,value:List(F,S)),(key:geoUrn,value:List(103644278,101165590)),(key:resultType,value:List(PEOPLE)))," +
+					"includeFiltersInResponse:false))"))
+		})
+
+		It("is what SearchProfiles sends", func() {
+			transport := newFakeTransport(http.StatusOK, searchResponseJSON())
+			_, err := newTestClient(transport).SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{
+				Keywords:       "venture capital",
+				Start:          10,
+				Count:          10,
+				NetworkFilters: []string{"F", "S"},
+				GeoURNs:        []string{"103644278", "101165590"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(transport.Requests()[0].URL.RawQuery).To(HaveSuffix("&variables=" + linkedinscraper.BuildSearchVariablesString(variables)))
+		})
+
+		It("sends a custom origin in the variables and the Referer", func() {
+			args, err := linkedinscraper.ProfileSearchArgsFromURL("https://www.linkedin.com/search/results/people/?keywords=investor&origin=GLOBAL_SEARCH_HEADER")
+			Expect(err).NotTo(HaveOccurred())
+
+			transport := newFakeTransport(http.StatusOK, searchResponseJSON())
+			_, err = newTestClient(transport).SearchProfiles(ctx, args)
+			Expect(err).NotTo(HaveOccurred())
+
+			req := transport.Requests()[0]
+			Expect(req.URL.RawQuery).To(ContainSubstring(",origin:GLOBAL_SEARCH_HEADER,"))
+			Expect(req.Header.Get("Referer")).To(HaveSuffix("&origin=GLOBAL_SEARCH_HEADER"))
+		})
+	})
+
+	Describe("invalid facets", func() {
+		It("hints at a bad facet value when a filtered search comes back empty", func() {
+			transport := newFakeTransport(http.StatusOK, loadFixture("search_invalid_facet.json"))
+			profiles, err := newTestClient(transport).SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{
+				Keywords: "investor",
+				GeoURNs:  []string{"10364427"},
+			})
+
+			Expect(err).To(MatchError(linkedinscraper.ErrInvalidSearchFacet))
+			Expect(err.Error()).To(ContainSubstring("10364427"))
+			Expect(profiles).To(BeNil())
+		})
+
+		It("returns an empty page for an unfiltered search without results", func() {
+			transport := newFakeTransport(http.StatusOK, loadFixture("search_invalid_facet.json"))
+			profiles, err := newTestClient(transport).SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profiles).To(BeEmpty())
+		})
+
+		It("returns an empty page for a network-only search without results", func() {
+			transport := newFakeTransport(http.StatusOK, loadFixture("search_invalid_facet.json"))
+			profiles, err := newTestClient(transport).SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{
+				Keywords:       "investor",
+				NetworkFilters: []string{linkedinscraper.NetworkFirstDegree},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profiles).To(BeEmpty())
+		})
+
+		It("accepts a filtered page that reports a total", func() {
+			transport := newFakeTransport(http.StatusOK, pagedSearchResponseJSON(40, 10, 45))
+			profiles, err := newTestClient(transport).SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{
+				Keywords:       "investor",
+				NetworkFilters: []string{linkedinscraper.NetworkFirstDegree},
+				Start:          40,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profiles).To(BeEmpty())
+		})
+	})
+
+	Describe("ProfileSearchArgsFromURL", func() {
+		DescribeTable("parses browser search URLs",
+			func(searchURL string, expected linkedinscraper.ProfileSearchArgs) {
+				args, err := linkedinscraper.ProfileSearchArgsFromURL(searchURL)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(args).To(Equal(expected))
+			},
+			Entry("keywords only",
+				"https://www.linkedin.com/search/results/people/?keywords=investor&origin=GLOBAL_SEARCH_HEADER&sid=xYz",
+				linkedinscraper.ProfileSearchArgs{Keywords: "investor", Origin: "GLOBAL_SEARCH_HEADER", Count: 10}),
+			Entry("encoded network filters",
+				"https://www.linkedin.com/search/results/people/?keywords=venture%20capital&network=%5B%22F%22%2C%22S%22%5D&origin=FACETED_SEARCH",
+				linkedinscraper.ProfileSearchArgs{Keywords: "venture capital", NetworkFilters: []string{"F", "S"}, Origin: "FACETED_SEARCH", Count: 10}),
+			Entry("as the client writes its Referer",
+				`https://www.linkedin.com/search/results/people/?keywords=investor&network=["F","O"]&geoUrn=["103644278"]&sortBy=["RECENTLY_JOINED"]&origin=FACETED_SEARCH`,
+				linkedinscraper.ProfileSearchArgs{
+					Keywords:       "investor",
+					NetworkFilters: []string{"F", "O"},
+					GeoURNs:        []string{"103644278"},
+					SortBy:         linkedinscraper.SortByRecentlyJoined,
+					Origin:         "FACETED_SEARCH",
+					Count:          10,
+				}),
+			Entry("a later page without the www host",
+				"https://linkedin.com/search/results/people?keywords=founder&page=3",
+				linkedinscraper.ProfileSearchArgs{Keywords: "founder", Start: 20, Count: 10}),
+		)
+
+		DescribeTable("rejects other URLs",
+			func(searchURL string, expected error) {
+				_, err := linkedinscraper.ProfileSearchArgsFromURL(searchURL)
+				Expect(err).To(MatchError(expected))
+			},
+			Entry("company search", "https://www.linkedin.com/search/results/companies/?keywords=acme", linkedinscraper.ErrInvalidSearchURL),
+			Entry("another host", "https://example.com/search/results/people/?keywords=investor", linkedinscraper.ErrInvalidSearchURL),
+			Entry("malformed facet", "https://www.linkedin.com/search/results/people/?keywords=investor&network=F", linkedinscraper.ErrInvalidSearchURL),
+			Entry("no keywords", "https://www.linkedin.com/search/results/people/?network=%5B%22F%22%5D", linkedinscraper.ErrKeywordsMissing),
+			Entry("unknown sort order", `https://www.linkedin.com/search/results/people/?keywords=investor&sortBy=["NEWEST"]`, linkedinscraper.ErrInvalidSortBy),
+		)
+	})
+
+	Describe("ProfileSearchArgs builders", func() {
+		var base linkedinscraper.ProfileSearchArgs
+
+		BeforeEach(func() {
+			base = linkedinscraper.ProfileSearchArgs{
+				Keywords:       "investor",
+				NetworkFilters: []string{linkedinscraper.NetworkFirstDegree},
+				GeoURNs:        []string{"103644278"},
+				Count:          10,
+			}
+		})
+
+		It("derives page-specific args without changing the original", func() {
+			original := base.Clone()
+
+			page := base.WithStart(20).WithCount(25).WithNetworkFilters(linkedinscraper.NetworkSecondDegree, linkedinscraper.NetworkThirdPlus)
+
+			Expect(page.Keywords).To(Equal("investor"))
+			Expect(page.Start).To(Equal(20))
+			Expect(page.Count).To(Equal(25))
+			Expect(page.NetworkFilters).To(Equal([]string{"S", "O"}))
+			Expect(page.GeoURNs).To(Equal([]string{"103644278"}))
+			Expect(base).To(Equal(original))
+		})
+
+		It("returns clones that share no slices", func() {
+			clone := base.Clone()
+			clone.NetworkFilters[0] = linkedinscraper.NetworkThirdPlus
+			clone.GeoURNs = append(clone.GeoURNs[:0], "90000084")
+
+			derived := base.WithStart(10)
+			derived.GeoURNs[0] = "90000091"
+
+			Expect(base.NetworkFilters).To(Equal([]string{"F"}))
+			Expect(base.GeoURNs).To(Equal([]string{"103644278"}))
+		})
+
+		It("does not alias the variadic filters", func() {
+			filters := []string{linkedinscraper.NetworkFirstDegree, linkedinscraper.NetworkSecondDegree}
+			args := base.WithNetworkFilters(filters...)
+			filters[0] = linkedinscraper.NetworkThirdPlus
+
+			Expect(args.NetworkFilters).To(Equal([]string{"F", "S"}))
+		})
+	})
+
+	Describe("network filters", func() {
+		It("maps the constants to LinkedIn's codes", func() {
+			Expect(linkedinscraper.NetworkFirstDegree).To(Equal("F"))
+			Expect(linkedinscraper.NetworkSecondDegree).To(Equal("S"))
+			Expect(linkedinscraper.NetworkThirdPlus).To(Equal("O"))
+			Expect(linkedinscraper.AllNetworkFilters()).To(Equal([]linkedinscraper.NetworkFilter{"F", "S", "O"}))
+		})
+
+		It("threads constants and raw codes into the query", func() {
+			transport := newFakeTransport(http.StatusOK, searchResponseJSON())
+			_, err := newTestClient(transport).SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{
+				Keywords:       "investor",
+				NetworkFilters: []linkedinscraper.NetworkFilter{linkedinscraper.NetworkFirstDegree, "S"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(transport.Requests()[0].URL.RawQuery).To(ContainSubstring("(key:network,value:List(F,S))"))
+		})
+	})
+
+	Describe("search intent", func() {
+		requestQuery := func(args linkedinscraper.ProfileSearchArgs) string {
+			transport := newFakeTransport(http.StatusOK, searchResponseJSON())
+			_, err := newTestClient(transport).SearchProfiles(ctx, args)
+			Expect(err).NotTo(HaveOccurred())
+			return transport.Requests()[0].URL.RawQuery
+		}
+
+		It("defaults to SEARCH_SRP", func() {
+			Expect(requestQuery(linkedinscraper.ProfileSearchArgs{Keywords: "investor"})).
+				To(ContainSubstring("flagshipSearchIntent:SEARCH_SRP,"))
+		})
+
+		It("threads a custom intent into the variables", func() {
+			Expect(requestQuery(linkedinscraper.ProfileSearchArgs{Keywords: "investor", SearchIntent: "SEARCH_MY_ITEMS"})).
+				To(ContainSubstring("flagshipSearchIntent:SEARCH_MY_ITEMS,"))
+		})
+	})
+	Describe("sort order", func() {
+		It("emits the sortBy facet", func() {
+			transport := newFakeTransport(http.StatusOK, searchResponseJSON())
+			_, err := newTestClient(transport).SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{
+				Keywords: "investor",
+				SortBy:   linkedinscraper.SortByRecentlyJoined,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(transport.Requests()[0].URL.RawQuery).To(ContainSubstring("(key:sortBy,value:List(RECENTLY_JOINED))"))
+		})
+
+		It("omits the facet by default", func() {
+			transport := newFakeTransport(http.StatusOK, searchResponseJSON())
+			_, err := newTestClient(transport).SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(transport.Requests()[0].URL.RawQuery).NotTo(ContainSubstring("sortBy"))
+		})
+
+		It("rejects unknown values without making a request", func() {
+			transport := newFakeTransport(http.StatusOK, searchResponseJSON())
+			_, err := newTestClient(transport).SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{Keywords: "investor", SortBy: "NEWEST"})
+			Expect(err).To(MatchError(linkedinscraper.ErrInvalidSortBy))
+			Expect(transport.Requests()).To(BeEmpty())
+		})
+	})
+	Describe("partial results", func() {
+		var body []byte
+
+		BeforeEach(func() {
+			partial := entityResult("urn:li:member:2", "John Roe", "", "", "https://www.linkedin.com/in/john-roe")
+			delete(partial, "primarySubtitle")
+			delete(partial, "secondarySubtitle")
+			body = searchResponseJSON(
+				entityResult("urn:li:member:1", "Jane Doe", "Investor", "Paris", "https://www.linkedin.com/in/jane-doe"),
+				partial,
+			)
+		})
+
+		It("skips partial view models by default", func() {
+			profiles := search(newTestClient(newFakeTransport(http.StatusOK, body)))
+			Expect(profiles).To(HaveLen(1))
+			Expect(profiles[0].URN).To(Equal("urn:li:member:1"))
+		})
+
+		It("includes them with the available fields in partial mode", func() {
+			profiles, err := newTestClient(newFakeTransport(http.StatusOK, body)).SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{
+				Keywords:              "investor",
+				IncludePartialResults: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(profiles).To(HaveLen(2))
+			Expect(profiles[1].URN).To(Equal("urn:li:member:2"))
+			Expect(profiles[1].FullName).To(Equal("John Roe"))
+			Expect(profiles[1].Headline).To(BeEmpty())
+			Expect(profiles[1].Location).To(BeEmpty())
+		})
+	})
+	Describe("empty texts", func() {
+		DescribeTable("treats null and empty subtitles alike",
+			func(headline interface{}) {
+				result := entityResult("urn:li:member:2", "John Roe", "", "Berlin", "https://www.linkedin.com/in/john-roe")
+				if headline == "absent" {
+					delete(result, "primarySubtitle")
+				} else {
+					result["primarySubtitle"] = headline
+				}
+				body := searchResponseJSON(result)
+
+				Expect(search(newTestClient(newFakeTransport(http.StatusOK, body)))).To(BeEmpty())
+
+				profiles, err := newTestClient(newFakeTransport(http.StatusOK, body)).SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{
+					Keywords:              "investor",
+					IncludePartialResults: true,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(profiles).To(HaveLen(1))
+				Expect(profiles[0].FullName).To(Equal("John Roe"))
+				Expect(profiles[0].Headline).To(BeEmpty())
+				Expect(profiles[0].Location).To(Equal("Berlin"))
+			},
+			Entry("null", nil),
+			Entry("empty string", ""),
+			Entry("empty text object", map[string]interface{}{"text": ""}),
+			Entry("missing", "absent"),
+		)
+	})
+
+	Describe("industry", func() {
+		It("resolves the industry referenced by the linked profile", func() {
+			profiles := search(newTestClient(newFakeTransport(http.StatusOK, loadFixture("search_industry.json"))))
+			Expect(profiles).To(HaveLen(2))
+
+			Expect(profiles[0].IndustryURN).To(Equal("urn:li:fsd_industry:43"))
+			Expect(profiles[0].Industry).To(Equal("Financial Services"))
+
+			Expect(profiles[1].IndustryURN).To(Equal("urn:li:fsd_industry:96"))
+			Expect(profiles[1].Industry).To(BeEmpty())
+		})
+	})
+
+	Describe("private members", func() {
+		It("flags private-mode results instead of using the placeholder name", func() {
+			profiles := search(newTestClient(newFakeTransport(http.StatusOK, loadFixture("search_private_member.json"))))
+			Expect(profiles).To(HaveLen(2))
+
+			Expect(profiles[0].FullName).To(Equal("Jane Doe"))
+			Expect(profiles[0].IsPrivate).To(BeFalse())
+
+			Expect(profiles[1].IsPrivate).To(BeTrue())
+			Expect(profiles[1].FullName).To(BeEmpty())
+			Expect(profiles[1].Headline).To(Equal("Investor"))
+			Expect(profiles[1].URN).To(Equal("urn:li:member:3"))
+		})
+
+		It("flags partial results without an identifier or headline", func() {
+			anonymous := entityResult("urn:li:member:4", "J. R.", "", "Berlin", "https://www.linkedin.com/search/results/people/headless")
+			delete(anonymous, "primarySubtitle")
+			profiles, err := newTestClient(newFakeTransport(http.StatusOK, searchResponseJSON(anonymous))).SearchProfiles(ctx, linkedinscraper.ProfileSearchArgs{
+				Keywords:              "investor",
+				IncludePartialResults: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profiles).To(HaveLen(1))
+			Expect(profiles[0].IsPrivate).To(BeTrue())
+			Expect(profiles[0].FullName).To(Equal("J. R."))
+		})
+	})
+
+	Describe("tracking IDs", func() {
+		It("captures the view model trackingId separately from the URN", func() {
+			tracked := entityResult("urn:li:member:1", "Jane Doe", "Investor", "Paris", "https://www.linkedin.com/in/jane-doe")
+			tracked["trackingId"] = "wN2kPq7XRm+d0bJ4Ye3s9w=="
+			body := searchResponseJSON(
+				tracked,
+				entityResult("urn:li:member:2", "John Roe", "Founder", "Berlin", "https://www.linkedin.com/in/john-roe"),
+			)
+
+			profiles := search(newTestClient(newFakeTransport(http.StatusOK, body)))
+			Expect(profiles).To(HaveLen(2))
+			Expect(profiles[0].URN).To(Equal("urn:li:member:1"))
+			Expect(profiles[0].TrackingID).To(Equal("wN2kPq7XRm+d0bJ4Ye3s9w=="))
+			Expect(profiles[1].TrackingID).To(BeEmpty())
+		})
+	})
+
+	Describe("profile enrichment", func() {
+		It("matches profiles whose URN is in a different namespace than the tracking URN", func() {
+			jane := profileEntity("jane-doe", "Jane", "Doe")
+			jane["objectUrn"] = "urn:li:member:1"
+			john := profileEntity("john-roe", "John", "Roe")
+			body := searchResponseJSON(
+				entityResult("urn:li:member:1", "Jane Doe", "Investor", "Paris", "https://www.linkedin.com/in/jane-doe"),
+				entityResult("urn:li:fs_miniProfile:ACoAAAjohn-roe", "John Roe", "Founder", "Berlin", "https://www.linkedin.com/in/john-roe"),
+				entityResult("urn:li:member:3", "Ada Poe", "Engineer", "London", "https://www.linkedin.com/in/ada-poe"),
+				jane,
+				john,
+			)
+
+			profiles := search(newTestClient(newFakeTransport(http.StatusOK, body)))
+			Expect(profiles).To(HaveLen(3))
+			Expect(profiles[0].PublicIdentifier).To(Equal("jane-doe"))
+			Expect(profiles[1].PublicIdentifier).To(Equal("john-roe"))
+			Expect(profiles[2].PublicIdentifier).To(BeEmpty())
+		})
+	})
+
+	Describe("network distance", func() {
+		It("derives the degree code from the result badge", func() {
+			second := entityResult("urn:li:member:1", "Jane Doe", "Investor", "Paris", "https://www.linkedin.com/in/jane-doe")
+			second["badgeText"] = map[string]interface{}{"text": "• 2nd"}
+			body := searchResponseJSON(
+				second,
+				entityResult("urn:li:member:2", "John Roe", "Founder", "Berlin", "https://www.linkedin.com/in/john-roe"),
+			)
+
+			profiles := search(newTestClient(newFakeTransport(http.StatusOK, body)))
+			Expect(profiles[0].NetworkDistance).To(Equal(linkedinscraper.NetworkSecondDegree))
+			Expect(profiles[1].NetworkDistance).To(BeEmpty())
+		})
+	})
+
+	Describe("secondary subtitle", func() {
+		DescribeTable("routes locations and insights to separate fields",
+			func(subtitle, location, insight string) {
+				body := searchResponseJSON(
+					entityResult("urn:li:member:1", "Jane Doe", "Investor", subtitle, "https://www.linkedin.com/in/jane-doe"),
+				)
+
+				profiles := search(newTestClient(newFakeTransport(http.StatusOK, body)))
+				Expect(profiles).To(HaveLen(1))
+				Expect(profiles[0].Location).To(Equal(location))
+				Expect(profiles[0].SubtitleInsight).To(Equal(insight))
+			},
+			Entry("a location", "San Francisco Bay Area", "San Francisco Bay Area", ""),
+			Entry("a current role", "Current: VP at X", "", "Current: VP at X"),
+			Entry("a past role", "Past: Analyst at Former Corp", "", "Past: Analyst at Former Corp"),
+		)
+	})
+})
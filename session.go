@@ -0,0 +1,129 @@
+package linkedinscraper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// sessionCookieNames are the cookies whose expiry bounds the authenticated session.
+var sessionCookieNames = map[string]bool{
+	"li_at":      true,
+	"JSESSIONID": true,
+}
+
+// SessionValidUntil makes a lightweight authenticated call and estimates when the session
+// expires from the Max-Age or Expires attributes of any session cookies LinkedIn refreshes
+// in the response, taking the earliest. When the response carries no such hint it returns
+// the zero time and ErrSessionExpiryUnknown; rejected credentials yield ErrUnauthorized.
+func (c *Client) SessionValidUntil(ctx context.Context) (time.Time, error) {
+	ctx = withOperation(ctx, "SessionValidUntil")
+
+	if c.config.Auth.LiAtCookie == "" || c.config.Auth.CSRFToken == "" {
+		return time.Time{}, ErrAuthMissing
+	}
+
+	customHeaders := http.Header{}
+	customHeaders.Set("Accept", AcceptHeaderValue)
+
+	resp, respBodyBytes, err := c.makeRequest(ctx, http.MethodGet, VoyagerMeURL, customHeaders, nil)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %w", ErrRequestFailed, err)
+	}
+	if err := statusError(resp, respBodyBytes); err != nil {
+		return time.Time{}, err
+	}
+
+	expiry, ok := sessionExpiry(resp.Cookies(), c.now())
+	if !ok {
+		return time.Time{}, ErrSessionExpiryUnknown
+	}
+	return expiry, nil
+}
+
+// sessionExpiry returns the earliest expiry among the session cookies, resolving Max-Age
+// relative to now. It reports false when no session cookie carries an expiry.
+func sessionExpiry(cookies []*http.Cookie, now time.Time) (time.Time, bool) {
+	var earliest time.Time
+	for _, cookie := range cookies {
+		if !sessionCookieNames[cookie.Name] {
+			continue
+		}
+		var expiry time.Time
+		switch {
+		case cookie.MaxAge > 0:
+			expiry = now.Add(time.Duration(cookie.MaxAge) * time.Second)
+		case cookie.MaxAge == 0 && !cookie.Expires.IsZero():
+			expiry = cookie.Expires
+		default:
+			continue
+		}
+		if earliest.IsZero() || expiry.Before(earliest) {
+			earliest = expiry
+		}
+	}
+	return earliest, !earliest.IsZero()
+}
+
+// challengePathMarkers are redirect targets LinkedIn uses instead of the feed when the
+// li_at session needs interactive verification or is no longer valid.
+var challengePathMarkers = []string{"/checkpoint/", "/uas/login", "/login", "/authwall"}
+
+// BootstrapAuth derives full credentials from an li_at cookie alone by loading the feed
+// and reading the JSESSIONID LinkedIn sets, which also serves as the CSRF token. Options
+// such as WithHTTPClient apply to the handshake request. When LinkedIn redirects to a
+// checkpoint or login page instead, ErrAuthChallenge is returned, and ErrNoSessionCookie
+// when the feed answers without setting a JSESSIONID.
+func BootstrapAuth(ctx context.Context, liAt string, opts ...ClientOption) (AuthCredentials, error) {
+	if liAt == "" {
+		return AuthCredentials{}, ErrAuthMissing
+	}
+
+	c, err := NewClient(&Config{Auth: AuthCredentials{LiAtCookie: liAt}, UserAgent: DefaultUserAgent}, opts...)
+	if err != nil {
+		return AuthCredentials{}, err
+	}
+
+	// Stop at the first response: its Set-Cookie carries the JSESSIONID, and a redirect
+	// is how LinkedIn signals a challenge.
+	httpClient := *c.httpClient
+	httpClient.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, LinkedInFeedURL, nil)
+	if err != nil {
+		return AuthCredentials{}, fmt.Errorf("%w: %v", ErrRequestBuildFailed, err)
+	}
+	req.Header.Set("User-Agent", c.config.UserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+	req.Header.Set("Accept-Language", AcceptLanguageHeaderValue)
+	req.Header.Set("Cookie", "li_at="+liAt)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return AuthCredentials{}, fmt.Errorf("%w: %w", ErrRequestFailed, err)
+	}
+	resp.Body.Close()
+
+	if location := resp.Header.Get("Location"); location != "" {
+		for _, marker := range challengePathMarkers {
+			if strings.Contains(location, marker) {
+				return AuthCredentials{}, fmt.Errorf("%w: redirected to %s", ErrAuthChallenge, location)
+			}
+		}
+	}
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return AuthCredentials{}, ErrUnauthorized
+	}
+
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == "JSESSIONID" && cookie.Value != "" {
+			jsessionID := strings.Trim(cookie.Value, `"`)
+			return AuthCredentials{LiAtCookie: liAt, CSRFToken: jsessionID, JSESSIONID: jsessionID}, nil
+		}
+	}
+	return AuthCredentials{}, ErrNoSessionCookie
+}
@@ -0,0 +1,108 @@
+package linkedinscraper_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	linkedinscraper "github.com/masa-finance/linkedin-scraper"
+)
+
+var _ = Describe("SessionValidUntil", func() {
+	respondWithCookies := func(setCookies ...string) *fakeTransport {
+		return &fakeTransport{handler: func(*http.Request) *http.Response {
+			resp := newResponse(http.StatusOK, []byte(`{}`))
+			for _, c := range setCookies {
+				resp.Header.Add("Set-Cookie", c)
+			}
+			return resp
+		}}
+	}
+
+	It("computes the expiry from a cookie max-age", func() {
+		transport := respondWithCookies(`JSESSIONID="ajax:test-csrf"; Max-Age=3600; Path=/; Secure`)
+
+		expiry, err := newTestClient(transport).SessionValidUntil(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(expiry).To(BeTemporally("~", time.Now().Add(time.Hour), 5*time.Second))
+		Expect(transport.Requests()[0].URL.String()).To(Equal(linkedinscraper.VoyagerMeURL))
+	})
+
+	It("takes the earliest of several session cookies", func() {
+		expires := time.Now().Add(30 * time.Minute).UTC().Truncate(time.Second)
+		transport := respondWithCookies(
+			`JSESSIONID="ajax:test-csrf"; Max-Age=3600`,
+			`li_at=test-li-at; Expires=`+expires.Format(http.TimeFormat),
+			`lang=v=2&lang=en-us; Max-Age=60`,
+		)
+
+		expiry, err := newTestClient(transport).SessionValidUntil(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expiry).To(BeTemporally("==", expires))
+	})
+
+	It("returns ErrSessionExpiryUnknown without session cookie hints", func() {
+		expiry, err := newTestClient(respondWithCookies()).SessionValidUntil(context.Background())
+		Expect(err).To(MatchError(linkedinscraper.ErrSessionExpiryUnknown))
+		Expect(expiry.IsZero()).To(BeTrue())
+	})
+
+	It("reports rejected credentials as ErrUnauthorized", func() {
+		_, err := newTestClient(newFakeTransport(http.StatusUnauthorized, nil)).SessionValidUntil(context.Background())
+		Expect(err).To(MatchError(linkedinscraper.ErrUnauthorized))
+	})
+})
+
+var _ = Describe("BootstrapAuth", func() {
+	withTransport := func(transport http.RoundTripper) linkedinscraper.ClientOption {
+		return linkedinscraper.WithHTTPClient(&http.Client{Transport: transport})
+	}
+
+	It("reads the JSESSIONID cookie set by the feed", func() {
+		transport := &fakeTransport{handler: func(*http.Request) *http.Response {
+			resp := newResponse(http.StatusOK, []byte("<html></html>"))
+			resp.Header.Add("Set-Cookie", `JSESSIONID="ajax:5738291046"; Path=/; Secure`)
+			resp.Header.Add("Set-Cookie", `lang=v=2&lang=en-us; Path=/`)
+			return resp
+		}}
+
+		auth, err := linkedinscraper.BootstrapAuth(context.Background(), "test-li-at", withTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(auth).To(Equal(linkedinscraper.AuthCredentials{
+			LiAtCookie: "test-li-at",
+			CSRFToken:  "ajax:5738291046",
+			JSESSIONID: "ajax:5738291046",
+		}))
+		request := transport.Requests()[0]
+		Expect(request.URL.String()).To(Equal(linkedinscraper.LinkedInFeedURL))
+		Expect(request.Header.Get("Cookie")).To(Equal("li_at=test-li-at"))
+	})
+
+	It("reports a checkpoint redirect as ErrAuthChallenge", func() {
+		transport := &fakeTransport{handler: func(*http.Request) *http.Response {
+			resp := newResponse(http.StatusFound, nil)
+			resp.Header.Set("Location", "https://www.linkedin.com/checkpoint/challenge/AgEs?ut=1")
+			resp.Header.Add("Set-Cookie", `JSESSIONID="ajax:5738291046"; Path=/`)
+			return resp
+		}}
+
+		_, err := linkedinscraper.BootstrapAuth(context.Background(), "test-li-at", withTransport(transport))
+		Expect(err).To(MatchError(linkedinscraper.ErrAuthChallenge))
+		Expect(transport.Requests()).To(HaveLen(1))
+	})
+
+	It("fails when no JSESSIONID is set", func() {
+		_, err := linkedinscraper.BootstrapAuth(context.Background(), "test-li-at", withTransport(newFakeTransport(http.StatusOK, nil)))
+		Expect(err).To(MatchError(linkedinscraper.ErrNoSessionCookie))
+	})
+
+	It("requires li_at", func() {
+		_, err := linkedinscraper.BootstrapAuth(context.Background(), "")
+		Expect(err).To(MatchError(linkedinscraper.ErrAuthMissing))
+	})
+})
@@ -0,0 +1,169 @@
+package linkedinscraper
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// WithPerProxyRateLimit throttles outgoing requests to rpm requests per minute per egress,
+// where the egress is the proxy the client's transport resolves for the request (or the
+// direct connection when it resolves none). Each proxy therefore gets its own budget, so
+// rotating across proxies scales the overall rate. Retries count against the budget.
+// Non-positive rpm disables throttling.
+func WithPerProxyRateLimit(rpm int) ClientOption {
+	return func(c *Client) {
+		if rpm > 0 {
+			c.throttle = newRequestThrottle(time.Minute / time.Duration(rpm))
+		} else {
+			c.throttle = nil
+		}
+	}
+}
+
+// proxyResolver is implemented by RoundTrippers that pick a proxy per request themselves,
+// e.g. rotating wrappers around an http.Transport.
+type proxyResolver interface {
+	Proxy(req *http.Request) (*url.URL, error)
+}
+
+// egressKey identifies the proxy req will leave through, or "" for a direct connection.
+func (c *Client) egressKey(req *http.Request) string {
+	var resolve func(*http.Request) (*url.URL, error)
+	switch transport := c.httpClient.Transport.(type) {
+	case nil:
+		resolve = http.DefaultTransport.(*http.Transport).Proxy
+	case *http.Transport:
+		resolve = transport.Proxy
+	case proxyResolver:
+		resolve = transport.Proxy
+	}
+	if resolve == nil {
+		return ""
+	}
+	proxyURL, err := resolve(req)
+	if err != nil || proxyURL == nil {
+		return ""
+	}
+	return proxyURL.Host
+}
+
+// requestThrottle spaces requests at least interval apart per key.
+type requestThrottle struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next map[string]time.Time // Earliest start of the next request per key
+}
+
+func newRequestThrottle(interval time.Duration) *requestThrottle {
+	return &requestThrottle{interval: interval, next: make(map[string]time.Time)}
+}
+
+// wait blocks until a request for key may start, reserving its slot, or until ctx is done.
+func (t *requestThrottle) wait(ctx context.Context, key string) error {
+	t.mu.Lock()
+	now := time.Now()
+	start := t.next[key]
+	if start.Before(now) {
+		start = now
+	}
+	t.next[key] = start.Add(t.interval)
+	t.mu.Unlock()
+
+	delay := time.Until(start)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// adaptiveLimiter bounds concurrent operations to a limit that adapts with
+// additive-increase/multiplicative-decrease: a rate-limited operation halves the limit,
+// and each run of limit successes raises it by one, always within [min, max].
+type adaptiveLimiter struct {
+	min, max int
+	onChange func(limit int) // Called under mu whenever the limit changes; may be nil
+
+	mu        sync.Mutex
+	limit     int
+	active    int
+	successes int           // Successes since the limit last changed
+	epoch     int           // Bumped on each decrease, so one burst of 429s halves the limit once
+	changed   chan struct{} // Closed and replaced whenever a slot frees up or the limit changes
+}
+
+func newAdaptiveLimiter(minLimit, maxLimit int, onChange func(limit int)) *adaptiveLimiter {
+	minLimit = max(1, min(minLimit, maxLimit))
+	return &adaptiveLimiter{
+		min:      minLimit,
+		max:      max(minLimit, maxLimit),
+		onChange: onChange,
+		limit:    max(minLimit, maxLimit),
+		changed:  make(chan struct{}),
+	}
+}
+
+// acquire blocks until fewer than limit operations are active and takes a slot, or until
+// ctx is done. The returned epoch must be passed back to release.
+func (l *adaptiveLimiter) acquire(ctx context.Context) (int, error) {
+	for {
+		l.mu.Lock()
+		if l.active < l.limit {
+			l.active++
+			epoch := l.epoch
+			l.mu.Unlock()
+			return epoch, nil
+		}
+		changed := l.changed
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// release frees the slot taken at epoch and adapts the limit to the operation's outcome.
+// Rate limits hit by operations started before the last decrease do not decrease it again.
+func (l *adaptiveLimiter) release(epoch int, rateLimited, succeeded bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.active--
+	switch {
+	case rateLimited && epoch == l.epoch:
+		l.epoch++
+		l.setLimit(max(l.min, l.limit/2))
+	case succeeded:
+		l.successes++
+		if l.successes >= l.limit && l.limit < l.max {
+			l.setLimit(l.limit + 1)
+		}
+	}
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// setLimit changes the limit and restarts the success count. Must be called with mu held.
+func (l *adaptiveLimiter) setLimit(limit int) {
+	l.successes = 0
+	if limit == l.limit {
+		return
+	}
+	l.limit = limit
+	if l.onChange != nil {
+		l.onChange(limit)
+	}
+}